@@ -0,0 +1,7 @@
+data "wikijs_locales" "installed" {
+  installed = true
+}
+
+output "installed_locales" {
+  value = data.wikijs_locales.installed.locales[*].code
+}
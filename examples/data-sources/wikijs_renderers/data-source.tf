@@ -0,0 +1,7 @@
+data "wikijs_renderers" "enabled" {
+  enabled = true
+}
+
+output "renderer_dependencies" {
+  value = { for renderer in data.wikijs_renderers.enabled.renderers : renderer.key => renderer.depends_on }
+}
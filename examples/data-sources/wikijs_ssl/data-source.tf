@@ -0,0 +1,5 @@
+data "wikijs_ssl" "this" {}
+
+output "certificate_expires_within_30_days" {
+  value = timecmp(data.wikijs_ssl.this.expiration_date, timeadd(timestamp(), "720h")) < 0
+}
@@ -0,0 +1,12 @@
+data "wikijs_system_info" "current" {
+  lifecycle {
+    postcondition {
+      condition     = split(".", self.current_version)[0] == "2" && tonumber(split(".", self.current_version)[1]) >= 5
+      error_message = "Wiki.js 2.5 or later is required."
+    }
+  }
+}
+
+output "wikijs_version" {
+  value = data.wikijs_system_info.current.current_version
+}
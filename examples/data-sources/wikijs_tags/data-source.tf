@@ -0,0 +1,7 @@
+data "wikijs_tags" "runbooks" {
+  query = "runbook"
+}
+
+output "runbook_tags" {
+  value = [for tag in data.wikijs_tags.runbooks.tags : tag.tag]
+}
@@ -0,0 +1 @@
+terraform import wikijs_analytics_provider.plausible plausible
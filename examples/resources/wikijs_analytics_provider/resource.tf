@@ -0,0 +1,15 @@
+resource "wikijs_analytics_provider" "plausible" {
+  key        = "plausible"
+  is_enabled = true
+
+  config = {
+    domain         = "wiki.example.com"
+    plausibleJsSrc = "https://plausible.example.com/js/plausible.js"
+  }
+}
+
+# Make sure Google Analytics stays off.
+resource "wikijs_analytics_provider" "google" {
+  key        = "google"
+  is_enabled = false
+}
@@ -0,0 +1 @@
+terraform import wikijs_asset.failover 42
@@ -0,0 +1,14 @@
+resource "wikijs_asset_folder" "diagrams" {
+  slug = "diagrams"
+}
+
+resource "wikijs_asset" "failover" {
+  folder_id = wikijs_asset_folder.diagrams.folder_id
+  filename  = "failover.png"
+  source    = "${path.module}/runbooks/failover.png"
+}
+
+# Pages link to the asset by its path, e.g. ![Failover](/diagrams/failover.png)
+output "failover_diagram_path" {
+  value = wikijs_asset.failover.path
+}
@@ -0,0 +1 @@
+terraform import wikijs_asset_folder.runbooks 1
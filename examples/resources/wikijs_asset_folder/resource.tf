@@ -0,0 +1,8 @@
+resource "wikijs_asset_folder" "runbooks" {
+  slug = "runbooks"
+}
+
+resource "wikijs_asset_folder" "diagrams" {
+  parent_folder_id = wikijs_asset_folder.runbooks.folder_id
+  slug             = "diagrams"
+}
@@ -0,0 +1 @@
+terraform import wikijs_comments_provider.example comments_provider
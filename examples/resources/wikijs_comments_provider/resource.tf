@@ -0,0 +1,11 @@
+resource "wikijs_comments_provider" "example" {
+  key = "default"
+
+  config = {
+    minDelay = "30"
+  }
+
+  sensitive_config = {
+    akismet = var.akismet_api_key
+  }
+}
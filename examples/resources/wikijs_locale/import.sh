@@ -0,0 +1 @@
+terraform import wikijs_locale.example locale
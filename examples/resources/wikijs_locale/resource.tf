@@ -0,0 +1,8 @@
+resource "wikijs_locale" "example" {
+  locale      = "en"
+  auto_update = true
+  namespacing = true
+  namespaces  = ["en", "fr", "de"]
+
+  installed_locales = ["ja"]
+}
@@ -0,0 +1 @@
+terraform import wikijs_logging_target.sentry sentry
@@ -0,0 +1,23 @@
+resource "wikijs_logging_target" "sentry" {
+  key        = "sentry"
+  is_enabled = true
+  level      = "error"
+
+  config = {
+    key = "https://public@sentry.example.com/1"
+  }
+}
+
+resource "wikijs_logging_target" "loggly" {
+  key        = "loggly"
+  is_enabled = true
+  level      = "info"
+
+  config = {
+    subdomain = "example"
+  }
+
+  sensitive_config = {
+    token = var.loggly_token
+  }
+}
@@ -0,0 +1 @@
+terraform import wikijs_mail_config.example mail_config
@@ -0,0 +1,13 @@
+resource "wikijs_mail_config" "example" {
+  sender_name  = "Wiki"
+  sender_email = "wiki@example.com"
+  host         = "smtp.example.com"
+  port         = 587
+  secure       = false
+  verify_ssl   = true
+  user         = "wiki@example.com"
+  password     = var.smtp_password
+
+  # Fail the apply if the settings cannot deliver mail.
+  test_recipient = "admin@example.com"
+}
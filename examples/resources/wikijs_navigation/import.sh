@@ -0,0 +1 @@
+terraform import wikijs_navigation.example en
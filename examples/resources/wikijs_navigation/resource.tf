@@ -0,0 +1,35 @@
+resource "wikijs_navigation" "example" {
+  locale = "en"
+  mode   = "MIXED"
+
+  items = [
+    {
+      kind        = "link"
+      label       = "Home"
+      icon        = "mdi-home"
+      target_type = "home"
+    },
+    {
+      kind  = "header"
+      label = "Documentation"
+    },
+    {
+      kind        = "link"
+      label       = "Getting started"
+      icon        = "mdi-rocket"
+      target_type = "page"
+      target      = "/getting-started"
+    },
+    {
+      kind = "divider"
+    },
+    {
+      kind              = "link"
+      label             = "Intranet"
+      target_type       = "url"
+      target            = "https://intranet.example.com"
+      visibility_mode   = "restricted"
+      visibility_groups = [2]
+    },
+  ]
+}
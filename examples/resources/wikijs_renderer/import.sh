@@ -0,0 +1 @@
+terraform import wikijs_renderer.markdown markdownCore
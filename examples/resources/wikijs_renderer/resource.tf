@@ -0,0 +1,20 @@
+resource "wikijs_renderer" "markdown" {
+  key        = "markdownCore"
+  is_enabled = true
+
+  config = {
+    linebreaks  = "true"
+    linkify     = "true"
+    typographer = "false"
+    underline   = "false"
+  }
+}
+
+resource "wikijs_renderer" "kroki" {
+  key        = "markdownKroki"
+  is_enabled = true
+
+  config = {
+    server = "https://kroki.example.com"
+  }
+}
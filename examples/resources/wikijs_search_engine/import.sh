@@ -0,0 +1 @@
+terraform import wikijs_search_engine.example search_engine
@@ -0,0 +1,10 @@
+resource "wikijs_search_engine" "example" {
+  key           = "elasticsearch"
+  rebuild_index = true
+
+  config = {
+    apiVersion = "7.x"
+    hosts      = "https://elasticsearch.example.com:9200"
+    indexName  = "wiki"
+  }
+}
@@ -0,0 +1 @@
+terraform import wikijs_security_config.example security_config
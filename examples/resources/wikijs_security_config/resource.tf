@@ -0,0 +1,12 @@
+resource "wikijs_security_config" "example" {
+  open_redirect   = true
+  iframe          = true
+  referrer_policy = true
+  sri             = true
+
+  hsts          = true
+  hsts_duration = 31536000
+
+  csp            = true
+  csp_directives = "default-src 'self'; img-src *"
+}
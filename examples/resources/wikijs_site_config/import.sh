@@ -0,0 +1 @@
+terraform import wikijs_site_config.example site_config
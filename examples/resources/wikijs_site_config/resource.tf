@@ -0,0 +1,11 @@
+resource "wikijs_site_config" "example" {
+  host            = "https://wiki.example.com"
+  title           = "Example wiki"
+  company         = "Example Ltd"
+  content_license = "ccby"
+  page_extensions = ["md", "html", "txt"]
+
+  auth_jwt_expiration  = "1h"
+  feature_page_ratings = false
+  upload_max_file_size = 10485760
+}
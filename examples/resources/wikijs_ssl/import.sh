@@ -0,0 +1 @@
+terraform import wikijs_ssl.this ssl
@@ -0,0 +1,12 @@
+resource "wikijs_ssl" "this" {
+  http_redirection = true
+
+  # Renew the certificate by changing the rotation.
+  renew_triggers = {
+    rotation = "2024-06"
+  }
+}
+
+output "certificate_expiration" {
+  value = wikijs_ssl.this.expiration_date
+}
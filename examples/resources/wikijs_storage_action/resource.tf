@@ -0,0 +1,17 @@
+resource "wikijs_storage_target" "git" {
+  key        = "git"
+  is_enabled = true
+  config = {
+    authType = "basic"
+    repoUrl  = "https://git.example.com/wiki.git"
+  }
+}
+
+# Sync the repository whenever its URL changes.
+resource "wikijs_storage_action" "sync" {
+  target_key = wikijs_storage_target.git.key
+  handler    = "sync"
+  triggers = {
+    repo_url = wikijs_storage_target.git.config.repoUrl
+  }
+}
@@ -0,0 +1 @@
+terraform import wikijs_storage_target.git git
@@ -0,0 +1,20 @@
+resource "wikijs_storage_target" "git" {
+  key           = "git"
+  is_enabled    = true
+  mode          = "sync"
+  sync_interval = "PT5M"
+
+  config = {
+    authType      = "basic"
+    repoUrl       = "https://git.example.com/wiki/content.git"
+    branch        = "main"
+    basicUsername = "wiki"
+    verifySSL     = "true"
+    defaultEmail  = "wiki@example.com"
+    defaultName   = "Wiki"
+  }
+
+  sensitive_config = {
+    basicPassword = var.git_token
+  }
+}
@@ -0,0 +1 @@
+terraform import wikijs_system_flags.debug system_flags
@@ -0,0 +1,6 @@
+# Debug LDAP logins while the branch is deployed, restored on destroy.
+resource "wikijs_system_flags" "debug" {
+  flags = {
+    ldapdebug = true
+  }
+}
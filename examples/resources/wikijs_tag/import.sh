@@ -0,0 +1 @@
+terraform import wikijs_tag.runbook 12
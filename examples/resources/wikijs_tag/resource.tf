@@ -0,0 +1,4 @@
+resource "wikijs_tag" "runbook" {
+  tag   = "runbook"
+  title = "Runbooks"
+}
@@ -0,0 +1 @@
+terraform import wikijs_theme.example theme
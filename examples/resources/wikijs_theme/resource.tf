@@ -0,0 +1,11 @@
+resource "wikijs_theme" "example" {
+  theme        = "default"
+  iconset      = "mdi"
+  dark_mode    = false
+  toc_position = "right"
+
+  inject_css_file = "${path.module}/theme.css"
+  inject_head     = <<-EOT
+    <meta name="robots" content="noarchive">
+  EOT
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type localesDataSourceType struct{}
+
+func (t localesDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Locales available for download and their installation state",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"installed": {
+				MarkdownDescription: "Only list installed (`true`) or not installed (`false`) locales",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"locales": {
+				MarkdownDescription: "Locales ordered by code",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"code": {
+						MarkdownDescription: "Code, e.g. `fr`",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"name": {
+						MarkdownDescription: "English name",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"native_name": {
+						MarkdownDescription: "Name in the language itself",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"availability": {
+						MarkdownDescription: "Percentage of the interface which is translated",
+						Type:                types.Int64Type,
+						Computed:            true,
+					},
+					"is_installed": {
+						MarkdownDescription: "Whether the locale is installed",
+						Type:                types.BoolType,
+						Computed:            true,
+					},
+					"is_rtl": {
+						MarkdownDescription: "Whether the language is written right to left",
+						Type:                types.BoolType,
+						Computed:            true,
+					},
+					"install_date": {
+						MarkdownDescription: "Date the locale was installed, empty if it is not installed",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t localesDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return localesDataSource{
+		provider: provider,
+	}, diags
+}
+
+type localesDataSourceData struct {
+	Id        types.String             `tfsdk:"id"`
+	Installed types.Bool               `tfsdk:"installed"`
+	Locales   []localeDataSourceLocale `tfsdk:"locales"`
+}
+
+type localeDataSourceLocale struct {
+	Code         types.String `tfsdk:"code"`
+	Name         types.String `tfsdk:"name"`
+	NativeName   types.String `tfsdk:"native_name"`
+	Availability types.Int64  `tfsdk:"availability"`
+	IsInstalled  types.Bool   `tfsdk:"is_installed"`
+	IsRTL        types.Bool   `tfsdk:"is_rtl"`
+	InstallDate  types.String `tfsdk:"install_date"`
+}
+
+type localesDataSource struct {
+	provider provider
+}
+
+func (d localesDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data localesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locales, err := d.provider.client.GetLocales()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read locales, got error: %s", err))
+		return
+	}
+	sort.Slice(locales, func(i, j int) bool { return locales[i].Code < locales[j].Code })
+
+	data.Id = types.String{Value: "locales"}
+	data.Locales = []localeDataSourceLocale{}
+	for _, locale := range locales {
+		if !data.Installed.Null && locale.IsInstalled != data.Installed.Value {
+			continue
+		}
+		data.Locales = append(data.Locales, localeDataSourceLocale{
+			Code:         types.String{Value: locale.Code},
+			Name:         types.String{Value: locale.Name},
+			NativeName:   types.String{Value: locale.NativeName},
+			Availability: types.Int64{Value: locale.Availability},
+			IsInstalled:  types.Bool{Value: locale.IsInstalled},
+			IsRTL:        types.Bool{Value: locale.IsRTL},
+			InstallDate:  types.String{Value: locale.InstallDate},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
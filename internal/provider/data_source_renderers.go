@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type renderersDataSourceType struct{}
+
+func (t renderersDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Renderers of the rendering pipeline and the renderers they depend on",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"enabled": {
+				MarkdownDescription: "Only list enabled (`true`) or disabled (`false`) renderers",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"renderers": {
+				MarkdownDescription: "Renderers ordered by key",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"key": {
+						MarkdownDescription: "Key, e.g. `markdownCore`",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"title": {
+						MarkdownDescription: "Title",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"description": {
+						MarkdownDescription: "Description",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"is_enabled": {
+						MarkdownDescription: "Whether the renderer is enabled",
+						Type:                types.BoolType,
+						Computed:            true,
+					},
+					"depends_on": {
+						MarkdownDescription: "Key of the renderer this renderer runs after, empty for the first renderers of the pipeline",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"input": {
+						MarkdownDescription: "Format the renderer reads, e.g. `markdown`",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"output": {
+						MarkdownDescription: "Format the renderer produces, e.g. `html`",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t renderersDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return renderersDataSource{
+		provider: provider,
+	}, diags
+}
+
+type renderersDataSourceData struct {
+	Id        types.String                 `tfsdk:"id"`
+	Enabled   types.Bool                   `tfsdk:"enabled"`
+	Renderers []rendererDataSourceRenderer `tfsdk:"renderers"`
+}
+
+type rendererDataSourceRenderer struct {
+	Key         types.String `tfsdk:"key"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
+	DependsOn   types.String `tfsdk:"depends_on"`
+	Input       types.String `tfsdk:"input"`
+	Output      types.String `tfsdk:"output"`
+}
+
+type renderersDataSource struct {
+	provider provider
+}
+
+func (d renderersDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data renderersDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	renderers, err := d.provider.client.GetRenderers()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read renderers, got error: %s", err))
+		return
+	}
+	sort.Slice(renderers, func(i, j int) bool { return renderers[i].Key < renderers[j].Key })
+
+	data.Id = types.String{Value: "renderers"}
+	data.Renderers = []rendererDataSourceRenderer{}
+	for _, renderer := range renderers {
+		if !data.Enabled.Null && renderer.IsEnabled != data.Enabled.Value {
+			continue
+		}
+		data.Renderers = append(data.Renderers, rendererDataSourceRenderer{
+			Key:         types.String{Value: renderer.Key},
+			Title:       types.String{Value: renderer.Title},
+			Description: types.String{Value: renderer.Description},
+			IsEnabled:   types.Bool{Value: renderer.IsEnabled},
+			DependsOn:   types.String{Value: renderer.DependsOn},
+			Input:       types.String{Value: renderer.Input},
+			Output:      types.String{Value: renderer.Output},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
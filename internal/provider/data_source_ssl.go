@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type sslDataSourceType struct{}
+
+func (t sslDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "HTTPS configuration and certificate status of the server, e.g. to monitor the expiry of Let's Encrypt certificates. " +
+			"Requires Wiki.js 2.5 or later",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"ssl_provider": {
+				MarkdownDescription: "SSL provider, `letsencrypt` if Wiki.js manages the certificate or `custom`. Empty if HTTPS is disabled",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"domain": {
+				MarkdownDescription: "Domain of the Let's Encrypt certificate",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"status": {
+				MarkdownDescription: "Status of the Let's Encrypt certificate",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"subscriber_email": {
+				MarkdownDescription: "Email address registered with Let's Encrypt",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"expiration_date": {
+				MarkdownDescription: "Expiration date of the Let's Encrypt certificate, e.g. `2030-01-01T00:00:00Z`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"http_port": {
+				MarkdownDescription: "Port Wiki.js serves HTTP on",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"https_port": {
+				MarkdownDescription: "Port Wiki.js serves HTTPS on",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"http_redirection": {
+				MarkdownDescription: "Whether HTTP requests are redirected to HTTPS",
+				Type:                types.BoolType,
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t sslDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return sslDataSource{
+		provider: provider,
+	}, diags
+}
+
+type sslDataSourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Provider        types.String `tfsdk:"ssl_provider"`
+	Domain          types.String `tfsdk:"domain"`
+	Status          types.String `tfsdk:"status"`
+	SubscriberEmail types.String `tfsdk:"subscriber_email"`
+	ExpirationDate  types.String `tfsdk:"expiration_date"`
+	HttpPort        types.Int64  `tfsdk:"http_port"`
+	HttpsPort       types.Int64  `tfsdk:"https_port"`
+	HttpRedirection types.Bool   `tfsdk:"http_redirection"`
+}
+
+func newSSLDataSourceData(info *wikijs.SSLInfo) sslDataSourceData {
+	return sslDataSourceData{
+		Id:              types.String{Value: "ssl"},
+		Provider:        types.String{Value: info.Provider},
+		Domain:          types.String{Value: info.Domain},
+		Status:          types.String{Value: info.Status},
+		SubscriberEmail: types.String{Value: info.SubscriberEmail},
+		ExpirationDate:  types.String{Value: info.ExpirationDate},
+		HttpPort:        types.Int64{Value: info.HttpPort},
+		HttpsPort:       types.Int64{Value: info.HttpsPort},
+		HttpRedirection: types.Bool{Value: info.HttpRedirection},
+	}
+}
+
+type sslDataSource struct {
+	provider provider
+}
+
+func (d sslDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	info, err := d.provider.client.GetSSLInfo()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSL info, got error: %s", err))
+		return
+	}
+
+	data := newSSLDataSourceData(info)
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
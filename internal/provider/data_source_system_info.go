@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type systemInfoDataSourceType struct{}
+
+func (t systemInfoDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Version and environment of the Wiki.js server, e.g. to assert a minimum version in a custom condition",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"current_version": {
+				MarkdownDescription: "Version of Wiki.js, e.g. `2.5.300`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"latest_version": {
+				MarkdownDescription: "Latest released version of Wiki.js",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"upgrade_capable": {
+				MarkdownDescription: "Whether Wiki.js can upgrade itself, i.e. it runs in a Docker container",
+				Type:                types.BoolType,
+				Computed:            true,
+			},
+			"db_type": {
+				MarkdownDescription: "Type of the database, e.g. `postgres`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"db_version": {
+				MarkdownDescription: "Version of the database",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"node_version": {
+				MarkdownDescription: "Version of Node.js running Wiki.js",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"hostname": {
+				MarkdownDescription: "Hostname of the server",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"operating_system": {
+				MarkdownDescription: "Operating system of the server",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"ram_total": {
+				MarkdownDescription: "Total memory of the server, e.g. `7.8 GB`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"working_directory": {
+				MarkdownDescription: "Working directory of Wiki.js",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"config_file": {
+				MarkdownDescription: "Path of the configuration file",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"telemetry": {
+				MarkdownDescription: "Whether telemetry is enabled",
+				Type:                types.BoolType,
+				Computed:            true,
+			},
+			"groups_total": {
+				MarkdownDescription: "Number of groups",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"pages_total": {
+				MarkdownDescription: "Number of pages",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"users_total": {
+				MarkdownDescription: "Number of users",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"tags_total": {
+				MarkdownDescription: "Number of tags",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t systemInfoDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return systemInfoDataSource{
+		provider: provider,
+	}, diags
+}
+
+type systemInfoDataSourceData struct {
+	Id               types.String `tfsdk:"id"`
+	CurrentVersion   types.String `tfsdk:"current_version"`
+	LatestVersion    types.String `tfsdk:"latest_version"`
+	UpgradeCapable   types.Bool   `tfsdk:"upgrade_capable"`
+	DbType           types.String `tfsdk:"db_type"`
+	DbVersion        types.String `tfsdk:"db_version"`
+	NodeVersion      types.String `tfsdk:"node_version"`
+	Hostname         types.String `tfsdk:"hostname"`
+	OperatingSystem  types.String `tfsdk:"operating_system"`
+	RamTotal         types.String `tfsdk:"ram_total"`
+	WorkingDirectory types.String `tfsdk:"working_directory"`
+	ConfigFile       types.String `tfsdk:"config_file"`
+	Telemetry        types.Bool   `tfsdk:"telemetry"`
+	GroupsTotal      types.Int64  `tfsdk:"groups_total"`
+	PagesTotal       types.Int64  `tfsdk:"pages_total"`
+	UsersTotal       types.Int64  `tfsdk:"users_total"`
+	TagsTotal        types.Int64  `tfsdk:"tags_total"`
+}
+
+type systemInfoDataSource struct {
+	provider provider
+}
+
+func (d systemInfoDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	info, err := d.provider.client.GetSystemInfo()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system info, got error: %s", err))
+		return
+	}
+
+	data := systemInfoDataSourceData{
+		Id:               types.String{Value: "system_info"},
+		CurrentVersion:   types.String{Value: info.CurrentVersion},
+		LatestVersion:    types.String{Value: info.LatestVersion},
+		UpgradeCapable:   types.Bool{Value: info.UpgradeCapable},
+		DbType:           types.String{Value: info.DbType},
+		DbVersion:        types.String{Value: info.DbVersion},
+		NodeVersion:      types.String{Value: info.NodeVersion},
+		Hostname:         types.String{Value: info.Hostname},
+		OperatingSystem:  types.String{Value: info.OperatingSystem},
+		RamTotal:         types.String{Value: info.RamTotal},
+		WorkingDirectory: types.String{Value: info.WorkingDirectory},
+		ConfigFile:       types.String{Value: info.ConfigFile},
+		Telemetry:        types.Bool{Value: info.Telemetry},
+		GroupsTotal:      types.Int64{Value: info.GroupsTotal},
+		PagesTotal:       types.Int64{Value: info.PagesTotal},
+		UsersTotal:       types.Int64{Value: info.UsersTotal},
+		TagsTotal:        types.Int64{Value: info.TagsTotal},
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
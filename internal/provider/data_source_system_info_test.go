@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSystemInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "wikijs_system_info" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wikijs_system_info.test", "id", "system_info"),
+					resource.TestCheckResourceAttrSet("data.wikijs_system_info.test", "current_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestSystemInfoDataSource(t *testing.T) {
+	if testServer == nil {
+		t.Skip("the version is only known for the fake Wiki.js server")
+	}
+
+	h := newDataSourceHarness(t, "wikijs_system_info")
+	if diags := h.readDataSource(map[string]interface{}{}); hasError(diags) {
+		t.Fatalf("read system info: %v", diags)
+	}
+	attributes := h.attributes()
+	if attributes["current_version"] != wikijstest.Version {
+		t.Errorf("current_version = %v, want %s", attributes["current_version"], wikijstest.Version)
+	}
+	if attributes["db_type"] != "postgres" || attributes["telemetry"] != false {
+		t.Errorf("unexpected attributes %v", attributes)
+	}
+	if users, ok := attributes["users_total"].(*big.Float); !ok || users.Cmp(big.NewFloat(1)) != 0 {
+		t.Errorf("users_total = %v, want 1", attributes["users_total"])
+	}
+}
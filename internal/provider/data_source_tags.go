@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tagsDataSourceType struct{}
+
+func (t tagsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Tags of pages, e.g. to use them in page rules of groups or in the navigation",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"query": {
+				MarkdownDescription: "Only list tags matching the query, e.g. `run` for `runbook`",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"tags": {
+				MarkdownDescription: "Tags ordered by tag",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "Id of the tag",
+						Type:                types.Int64Type,
+						Computed:            true,
+					},
+					"tag": {
+						MarkdownDescription: "Tag as set on pages",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"title": {
+						MarkdownDescription: "Title shown for the tag",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t tagsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return tagsDataSource{
+		provider: provider,
+	}, diags
+}
+
+type tagsDataSourceData struct {
+	Id    types.String       `tfsdk:"id"`
+	Query types.String       `tfsdk:"query"`
+	Tags  []tagDataSourceTag `tfsdk:"tags"`
+}
+
+type tagDataSourceTag struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Tag   types.String `tfsdk:"tag"`
+	Title types.String `tfsdk:"title"`
+}
+
+type tagsDataSource struct {
+	provider provider
+}
+
+func (d tagsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data tagsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := d.provider.client.GetPageTags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tags, got error: %s", err))
+		return
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Tag < tags[j].Tag })
+
+	// searchTags only returns the tags, their ids and titles come from the
+	// full list.
+	var matches map[string]bool
+	if query := stringPointer(data.Query); query != nil {
+		found, err := d.provider.client.SearchPageTags(*query)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search tags, got error: %s", err))
+			return
+		}
+		matches = map[string]bool{}
+		for _, tag := range found {
+			matches[tag] = true
+		}
+	}
+
+	data.Id = types.String{Value: "tags"}
+	data.Tags = []tagDataSourceTag{}
+	for _, tag := range tags {
+		if matches != nil && !matches[tag.Tag] {
+			continue
+		}
+		data.Tags = append(data.Tags, tagDataSourceTag{
+			Id:    types.Int64{Value: tag.Id},
+			Tag:   types.String{Value: tag.Tag},
+			Title: types.String{Value: tag.Title},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
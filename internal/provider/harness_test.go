@@ -0,0 +1,478 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testHarness plans and applies resources and reads data sources through the
+// provider server like Terraform would, but in-process and against the
+// client the tests are configured with. It lets resources be tested without
+// the Terraform CLI; acceptance tests remain the reference.
+type testHarness struct {
+	t          *testing.T
+	ctx        context.Context
+	server     tfprotov6.ProviderServer
+	typeName   string
+	schemaType tftypes.Object
+	computed   map[string]bool
+	state      tftypes.Value
+}
+
+func newResourceHarness(t *testing.T, typeName string) *testHarness {
+	ctx := context.Background()
+	resourceTypes, _ := testAccProvider.GetResources(ctx)
+	resourceType, ok := resourceTypes[typeName]
+	if !ok {
+		t.Fatalf("unknown resource type %s", typeName)
+	}
+	schema, _ := resourceType.GetSchema(ctx)
+	h := newHarness(t, typeName, schema.TerraformType(ctx).(tftypes.Object))
+	for name, attribute := range schema.Attributes {
+		h.computed[name] = attribute.Computed
+	}
+	return h
+}
+
+func newDataSourceHarness(t *testing.T, typeName string) *testHarness {
+	ctx := context.Background()
+	dataSourceTypes, _ := testAccProvider.GetDataSources(ctx)
+	dataSourceType, ok := dataSourceTypes[typeName]
+	if !ok {
+		t.Fatalf("unknown data source type %s", typeName)
+	}
+	schema, _ := dataSourceType.GetSchema(ctx)
+	return newHarness(t, typeName, schema.TerraformType(ctx).(tftypes.Object))
+}
+
+func newHarness(t *testing.T, typeName string, schemaType tftypes.Object) *testHarness {
+	server, err := providerserver.NewProtocol6WithError(testAccProvider)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testHarness{
+		t:          t,
+		ctx:        context.Background(),
+		server:     server,
+		typeName:   typeName,
+		schemaType: schemaType,
+		computed:   map[string]bool{},
+		state:      tftypes.NewValue(schemaType, nil),
+	}
+}
+
+// apply validates, plans and applies config, replacing the resource if the
+// plan requires it. It returns all diagnostics and stops at the first step
+// reporting an error.
+func (h *testHarness) apply(config map[string]interface{}) []*tfprotov6.Diagnostic {
+	configValue := h.object(config)
+	configDynamicValue := h.dynamicValue(configValue)
+
+	validateResponse, err := h.server.ValidateResourceConfig(h.ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: h.typeName,
+		Config:   configDynamicValue,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	diags := validateResponse.Diagnostics
+	if hasError(diags) {
+		return diags
+	}
+
+	planResponse := h.plan(configValue)
+	diags = append(diags, planResponse.Diagnostics...)
+	if hasError(diags) {
+		return diags
+	}
+
+	if len(planResponse.RequiresReplace) > 0 && !h.state.IsNull() {
+		diags = append(diags, h.destroy()...)
+		if hasError(diags) {
+			return diags
+		}
+		planResponse = h.plan(configValue)
+		diags = append(diags, planResponse.Diagnostics...)
+		if hasError(diags) {
+			return diags
+		}
+	}
+
+	applyResponse, err := h.server.ApplyResourceChange(h.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     h.typeName,
+		PriorState:   h.dynamicValue(h.state),
+		PlannedState: planResponse.PlannedState,
+		Config:       configDynamicValue,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	diags = append(diags, applyResponse.Diagnostics...)
+	if applyResponse.NewState != nil {
+		h.state = h.value(applyResponse.NewState)
+	}
+	if !hasError(diags) {
+		// Terraform rejects applied values which differ from known planned values.
+		if paths := inconsistentPaths("", h.value(planResponse.PlannedState), h.state); len(paths) > 0 {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Provider produced inconsistent result after apply",
+				Detail:   "Applied values differ from the plan at: " + strings.Join(paths, ", "),
+			})
+		}
+	}
+	return diags
+}
+
+// inconsistentPaths returns the paths at which applied differs from the known
+// values of planned, like the consistency check of Terraform after apply.
+func inconsistentPaths(path string, planned, applied tftypes.Value) []string {
+	if !planned.IsKnown() || planned.IsFullyKnown() && planned.Equal(applied) {
+		return nil
+	}
+	if planned.IsNull() || applied.IsNull() || !applied.IsKnown() {
+		return []string{pathOrRoot(path)}
+	}
+
+	var paths []string
+	switch {
+	case planned.Type().Is(tftypes.Object{}) || planned.Type().Is(tftypes.Map{}):
+		plannedElements := map[string]tftypes.Value{}
+		appliedElements := map[string]tftypes.Value{}
+		_ = planned.As(&plannedElements)
+		_ = applied.As(&appliedElements)
+		for name, element := range plannedElements {
+			elementPath := strings.TrimPrefix(path+"."+name, ".")
+			appliedElement, ok := appliedElements[name]
+			if !ok {
+				paths = append(paths, elementPath)
+				continue
+			}
+			paths = append(paths, inconsistentPaths(elementPath, element, appliedElement)...)
+		}
+		for name := range appliedElements {
+			if _, ok := plannedElements[name]; !ok {
+				paths = append(paths, strings.TrimPrefix(path+"."+name, "."))
+			}
+		}
+	case planned.Type().Is(tftypes.List{}) || planned.Type().Is(tftypes.Tuple{}):
+		var plannedElements, appliedElements []tftypes.Value
+		_ = planned.As(&plannedElements)
+		_ = applied.As(&appliedElements)
+		if len(plannedElements) != len(appliedElements) {
+			return []string{pathOrRoot(path)}
+		}
+		for i := range plannedElements {
+			paths = append(paths, inconsistentPaths(fmt.Sprintf("%s[%d]", path, i), plannedElements[i], appliedElements[i])...)
+		}
+	default:
+		// Sets with unknown elements cannot be matched element by element.
+		if planned.IsFullyKnown() {
+			return []string{pathOrRoot(path)}
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+	return path
+}
+
+func (h *testHarness) plan(configValue tftypes.Value) *tfprotov6.PlanResourceChangeResponse {
+	planResponse, err := h.server.PlanResourceChange(h.ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         h.typeName,
+		PriorState:       h.dynamicValue(h.state),
+		ProposedNewState: h.dynamicValue(h.proposedNewState(configValue)),
+		Config:           h.dynamicValue(configValue),
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return planResponse
+}
+
+// proposedNewState merges the prior state into unset computed attributes
+// like Terraform does. Only top level attributes are merged, unset optional
+// attributes stay null.
+func (h *testHarness) proposedNewState(configValue tftypes.Value) tftypes.Value {
+	if h.state.IsNull() {
+		return configValue
+	}
+	// As shares the map of the value, so the attributes are copied to leave
+	// the config unchanged.
+	attributes := map[string]tftypes.Value{}
+	_ = configValue.As(&attributes)
+	prior := map[string]tftypes.Value{}
+	_ = h.state.As(&prior)
+	proposed := map[string]tftypes.Value{}
+	for name, value := range attributes {
+		proposed[name] = value
+		if value.IsNull() && h.computed[name] {
+			proposed[name] = prior[name]
+		}
+	}
+	return tftypes.NewValue(h.schemaType, proposed)
+}
+
+// planOnly plans config against the current state without applying it.
+func (h *testHarness) planOnly(config map[string]interface{}) *tfprotov6.PlanResourceChangeResponse {
+	return h.plan(h.object(config))
+}
+
+func (h *testHarness) read() []*tfprotov6.Diagnostic {
+	readResponse, err := h.server.ReadResource(h.ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     h.typeName,
+		CurrentState: h.dynamicValue(h.state),
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if readResponse.NewState != nil {
+		h.state = h.value(readResponse.NewState)
+	}
+	return readResponse.Diagnostics
+}
+
+func (h *testHarness) importState(id string) []*tfprotov6.Diagnostic {
+	importResponse, err := h.server.ImportResourceState(h.ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: h.typeName,
+		ID:       id,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if hasError(importResponse.Diagnostics) {
+		return importResponse.Diagnostics
+	}
+	h.state = h.value(importResponse.ImportedResources[0].State)
+	return append(importResponse.Diagnostics, h.read()...)
+}
+
+func (h *testHarness) destroy() []*tfprotov6.Diagnostic {
+	nullValue := h.dynamicValue(tftypes.NewValue(h.schemaType, nil))
+	planResponse, err := h.server.PlanResourceChange(h.ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         h.typeName,
+		PriorState:       h.dynamicValue(h.state),
+		ProposedNewState: nullValue,
+		Config:           nullValue,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if hasError(planResponse.Diagnostics) {
+		return planResponse.Diagnostics
+	}
+	applyResponse, err := h.server.ApplyResourceChange(h.ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     h.typeName,
+		PriorState:   h.dynamicValue(h.state),
+		PlannedState: nullValue,
+		Config:       nullValue,
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	h.state = tftypes.NewValue(h.schemaType, nil)
+	return append(planResponse.Diagnostics, applyResponse.Diagnostics...)
+}
+
+// readDataSource reads a data source with config and stores the result as state.
+func (h *testHarness) readDataSource(config map[string]interface{}) []*tfprotov6.Diagnostic {
+	readResponse, err := h.server.ReadDataSource(h.ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: h.typeName,
+		Config:   h.dynamicValue(h.object(config)),
+	})
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if readResponse.State != nil {
+		h.state = h.value(readResponse.State)
+	}
+	return readResponse.Diagnostics
+}
+
+// attributes returns the state as Go values: strings, bools, *big.Float,
+// []interface{} and map[string]interface{}.
+func (h *testHarness) attributes() map[string]interface{} {
+	if h.state.IsNull() {
+		return nil
+	}
+	return fromTerraformValue(h.state).(map[string]interface{})
+}
+
+// plannedAttributes returns the planned state of a plan as Go values, see
+// attributes.
+func (h *testHarness) plannedAttributes(plan *tfprotov6.PlanResourceChangeResponse) map[string]interface{} {
+	value := h.value(plan.PlannedState)
+	if value.IsNull() {
+		return nil
+	}
+	return fromTerraformValue(value).(map[string]interface{})
+}
+
+func (h *testHarness) object(config map[string]interface{}) tftypes.Value {
+	return toTerraformValue(h.t, h.schemaType, config)
+}
+
+func (h *testHarness) dynamicValue(value tftypes.Value) *tfprotov6.DynamicValue {
+	dynamicValue, err := tfprotov6.NewDynamicValue(h.schemaType, value)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return &dynamicValue
+}
+
+func (h *testHarness) value(dynamicValue *tfprotov6.DynamicValue) tftypes.Value {
+	value, err := dynamicValue.Unmarshal(h.schemaType)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return value
+}
+
+func hasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, diag := range diags {
+		if diag.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// diagnosticSummaries returns the summaries of diagnostics with severity.
+func diagnosticSummaries(diags []*tfprotov6.Diagnostic, severity tfprotov6.DiagnosticSeverity) []string {
+	summaries := []string{}
+	for _, diag := range diags {
+		if diag.Severity == severity {
+			summaries = append(summaries, diag.Summary)
+		}
+	}
+	return summaries
+}
+
+// toTerraformValue converts Go values to a value of type typ. Objects are
+// given as map[string]interface{} where missing attributes are null, lists
+// and sets as []interface{} or []string, maps as map[string]string or
+// map[string]interface{}.
+func toTerraformValue(t *testing.T, typ tftypes.Type, value interface{}) tftypes.Value {
+	if value == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+	if terraformValue, ok := value.(tftypes.Value); ok {
+		return terraformValue
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		attributes := value.(map[string]interface{})
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range typ.AttributeTypes {
+			values[name] = toTerraformValue(t, attributeType, attributes[name])
+		}
+		for name := range attributes {
+			if _, ok := typ.AttributeTypes[name]; !ok {
+				t.Fatalf("unknown attribute %s", name)
+			}
+		}
+		return tftypes.NewValue(typ, values)
+	case tftypes.List:
+		return tftypes.NewValue(typ, toTerraformValues(t, typ.ElementType, value))
+	case tftypes.Set:
+		return tftypes.NewValue(typ, toTerraformValues(t, typ.ElementType, value))
+	case tftypes.Map:
+		values := map[string]tftypes.Value{}
+		switch elements := value.(type) {
+		case map[string]string:
+			for key, element := range elements {
+				values[key] = toTerraformValue(t, typ.ElementType, element)
+			}
+		case map[string]bool:
+			for key, element := range elements {
+				values[key] = toTerraformValue(t, typ.ElementType, element)
+			}
+		case map[string]interface{}:
+			for key, element := range elements {
+				values[key] = toTerraformValue(t, typ.ElementType, element)
+			}
+		}
+		return tftypes.NewValue(typ, values)
+	}
+
+	if typ.Is(tftypes.Number) {
+		switch number := value.(type) {
+		case int:
+			return tftypes.NewValue(typ, big.NewFloat(float64(number)))
+		case int64:
+			return tftypes.NewValue(typ, big.NewFloat(float64(number)))
+		}
+	}
+	return tftypes.NewValue(typ, value)
+}
+
+func toTerraformValues(t *testing.T, elementType tftypes.Type, value interface{}) []tftypes.Value {
+	values := []tftypes.Value{}
+	switch elements := value.(type) {
+	case []string:
+		for _, element := range elements {
+			values = append(values, toTerraformValue(t, elementType, element))
+		}
+	case []interface{}:
+		for _, element := range elements {
+			values = append(values, toTerraformValue(t, elementType, element))
+		}
+	case []map[string]interface{}:
+		for _, element := range elements {
+			values = append(values, toTerraformValue(t, elementType, element))
+		}
+	}
+	return values
+}
+
+func fromTerraformValue(value tftypes.Value) interface{} {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	typ := value.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		return s
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return b
+	case typ.Is(tftypes.Number):
+		n := big.NewFloat(0)
+		_ = value.As(&n)
+		return n
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+		result := []interface{}{}
+		for _, element := range elements {
+			result = append(result, fromTerraformValue(element))
+		}
+		return result
+	default:
+		var elements map[string]tftypes.Value
+		_ = value.As(&elements)
+		result := map[string]interface{}{}
+		for key, element := range elements {
+			result[key] = fromTerraformValue(element)
+		}
+		return result
+	}
+}
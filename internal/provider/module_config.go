@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// moduleConfigAttributes returns the attributes for the config of a module
+// like a storage target or search engine. Only the configured keys are
+// managed, the other keys keep their value.
+func moduleConfigAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"config": {
+			MarkdownDescription: "Config values by key. Booleans and numbers are given as strings, e.g. `\"true\"`",
+			Type:                types.MapType{ElemType: types.StringType},
+			Optional:            true,
+		},
+		"sensitive_config": {
+			MarkdownDescription: "Config values of sensitive keys like passwords. Changes made outside of Terraform are not detected",
+			Type:                types.MapType{ElemType: types.StringType},
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+// moduleConfig returns the current values of properties with the configured
+// values applied, converted to the type of each property.
+func moduleConfig(ctx context.Context, properties []wikijs.ModuleConfigProperty, config types.Map, sensitiveConfig types.Map) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := wikijs.ModuleConfigValues(properties)
+	byKey := map[string]wikijs.ModuleConfigProperty{}
+	keys := []string{}
+	for _, property := range properties {
+		byKey[property.Key] = property
+		keys = append(keys, property.Key)
+	}
+	sort.Strings(keys)
+
+	apply := func(attribute string, configured types.Map, sensitive bool) {
+		if configured.Null || configured.Unknown {
+			return
+		}
+		for key, element := range configured.Elems {
+			path := tftypes.NewAttributePath().WithAttributeName(attribute).WithElementKeyString(key)
+			property, ok := byKey[key]
+			if !ok {
+				diags.AddAttributeError(path, "Unknown Config Key",
+					fmt.Sprintf("Config key %s does not exist, valid keys are: %s", key, strings.Join(keys, ", ")))
+				continue
+			}
+			if property.Sensitive && !sensitive {
+				diags.AddAttributeError(path, "Sensitive Config Key",
+					fmt.Sprintf("Config key %s is sensitive and must be set in sensitive_config.", key))
+				continue
+			}
+			value, ok := element.(types.String)
+			if !ok || value.Unknown {
+				continue
+			}
+			converted, err := moduleConfigValue(property, value.Value)
+			if err != nil {
+				diags.AddAttributeError(path, "Invalid Config Value", err.Error())
+				continue
+			}
+			values[key] = converted
+		}
+	}
+	apply("config", config, false)
+	apply("sensitive_config", sensitiveConfig, true)
+
+	return values, diags
+}
+
+// moduleConfigValue converts a configured value to the type of property.
+func moduleConfigValue(property wikijs.ModuleConfigProperty, value string) (interface{}, error) {
+	switch property.Type {
+	case "Boolean":
+		converted, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("Config key %s must be a boolean, got: %q", property.Key, value)
+		}
+		return converted, nil
+	case "Number":
+		converted, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Config key %s must be a number, got: %q", property.Key, value)
+		}
+		return converted, nil
+	}
+	return value, nil
+}
+
+// moduleConfigString formats a config value read from Wiki.js.
+func moduleConfigString(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case bool:
+		return strconv.FormatBool(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
+
+// newModuleConfig returns the current values of the keys in prior. Values of
+// prior which convert to the current value are kept as they are written.
+func newModuleConfig(properties []wikijs.ModuleConfigProperty, prior types.Map) types.Map {
+	config := types.Map{ElemType: types.StringType, Null: prior.Null}
+	if prior.Null || prior.Unknown {
+		return config
+	}
+
+	byKey := map[string]wikijs.ModuleConfigProperty{}
+	for _, property := range properties {
+		byKey[property.Key] = property
+	}
+
+	config.Elems = map[string]attr.Value{}
+	for key, element := range prior.Elems {
+		property, ok := byKey[key]
+		if !ok {
+			continue
+		}
+		current := moduleConfigString(property.Value)
+		if value, ok := element.(types.String); ok && !value.Unknown {
+			if converted, err := moduleConfigValue(property, value.Value); err == nil && moduleConfigString(converted) == current {
+				config.Elems[key] = value
+				continue
+			}
+		}
+		config.Elems[key] = types.String{Value: current}
+	}
+	return config
+}
+
+// module holds the fields shared by the modules Wiki.js only updates as a
+// whole list, like analytics providers or search engines.
+type module struct {
+	Key       string
+	Title     string
+	IsEnabled bool
+	// Level is the level of logging targets, empty for other modules.
+	Level string
+	// Config is read with wikijs.DecodeModuleConfig and sent encoded with
+	// wikijs.EncodeModuleConfig.
+	Config []wikijs.KeyValuePair
+}
+
+// moduleList reads and updates a list of modules. Wiki.js expects the full
+// list on update, so the modules not managed by a resource are sent as they
+// are.
+type moduleList struct {
+	// name is the name of a module in messages, e.g. "analytics provider".
+	name string
+	// single is set for lists of which only one module is active, like the
+	// search engines.
+	single bool
+	// get reads all modules.
+	get func() ([]module, error)
+	// update saves all modules.
+	update func([]module) error
+}
+
+// find returns the index of the module with key.
+func (l moduleList) find(modules []module, key string) (int, error) {
+	keys := []string{}
+	for i, module := range modules {
+		if module.Key == key {
+			return i, nil
+		}
+		keys = append(keys, module.Key)
+	}
+	return -1, fmt.Errorf("%s %s does not exist, valid keys are: %s", l.name, key, strings.Join(keys, ", "))
+}
+
+// inputs returns the modules as input for update, keeping their current
+// values.
+func (l moduleList) inputs(modules []module) ([]module, error) {
+	inputs := make([]module, len(modules))
+	for i, module := range modules {
+		properties, err := wikijs.DecodeModuleConfig(module.Config)
+		if err != nil {
+			return nil, err
+		}
+		module.Config, err = wikijs.EncodeModuleConfig(wikijs.ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs[i] = module
+	}
+	return inputs, nil
+}
+
+// read returns the module with key, or the active module if only one can be
+// active. It returns nil if none is active.
+func (l moduleList) read(key string) (*module, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	modules, err := l.get()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", l.name, err))
+		return nil, diags
+	}
+	if l.single {
+		for _, module := range modules {
+			if module.IsEnabled {
+				return &module, diags
+			}
+		}
+		return nil, diags
+	}
+	i, err := l.find(modules, key)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", l.name, err))
+		return nil, diags
+	}
+	return &modules[i], diags
+}
+
+// apply updates the config of the module with key and enables or disables
+// it. If only one module can be active, the module is enabled and all others
+// are disabled. The module is returned as read after the update.
+func (l moduleList) apply(ctx context.Context, key string, enabled bool, config types.Map, sensitiveConfig types.Map) (*module, diag.Diagnostics) {
+	return l.applyLevel(ctx, key, enabled, nil, config, sensitiveConfig)
+}
+
+// applyLevel is apply for logging targets, which also sets the level of the
+// module unless it is nil.
+func (l moduleList) applyLevel(ctx context.Context, key string, enabled bool, level *string, config types.Map, sensitiveConfig types.Map) (*module, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	modules, err := l.get()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", l.name, err))
+		return nil, diags
+	}
+	i, err := l.find(modules, key)
+	if err != nil {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("key"), "Unknown "+strings.Title(l.name), err.Error())
+		return nil, diags
+	}
+
+	inputs, err := l.inputs(modules)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", l.name, err))
+		return nil, diags
+	}
+	if l.single {
+		for j := range inputs {
+			inputs[j].IsEnabled = j == i
+		}
+	} else {
+		inputs[i].IsEnabled = enabled
+	}
+	if level != nil {
+		inputs[i].Level = *level
+	}
+
+	properties, err := wikijs.DecodeModuleConfig(modules[i].Config)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", l.name, err))
+		return nil, diags
+	}
+	values, moreDiags := moduleConfig(ctx, properties, config, sensitiveConfig)
+	diags.Append(moreDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	inputs[i].Config, err = wikijs.EncodeModuleConfig(values)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode %s config, got error: %s", l.name, err))
+		return nil, diags
+	}
+
+	err = l.update(inputs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update %s, got error: %s", l.name, err))
+		return nil, diags
+	}
+
+	modules, err = l.get()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", l.name, err))
+		return nil, diags
+	}
+	i, err = l.find(modules, key)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", l.name, err))
+		return nil, diags
+	}
+	return &modules[i], diags
+}
+
+// disable disables the module with key, used by resources of lists in
+// which several modules can be active.
+func (l moduleList) disable(key string) diag.Diagnostics {
+	return l.set(func(input *module) {
+		if input.Key == key {
+			input.IsEnabled = false
+		}
+	}, "Unable to disable "+l.name)
+}
+
+// activate enables the module with key and disables all others, used by
+// resources of lists with a single active module.
+func (l moduleList) activate(key string) diag.Diagnostics {
+	return l.set(func(input *module) {
+		input.IsEnabled = input.Key == key
+	}, fmt.Sprintf("Unable to activate the %s %s", key, l.name))
+}
+
+// set changes the modules with change, keeping their config.
+func (l moduleList) set(change func(input *module), message string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	modules, err := l.get()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", l.name, err))
+		return diags
+	}
+	inputs, err := l.inputs(modules)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", l.name, err))
+		return diags
+	}
+	for i := range inputs {
+		change(&inputs[i])
+	}
+
+	err = l.update(inputs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", message, err))
+	}
+	return diags
+}
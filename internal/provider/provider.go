@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/camjjack/terraform-provider-wikijs/wikijs"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -44,6 +46,14 @@ type providerData struct {
 	InitialSetup  types.Bool   `tfsdk:"initial_setup"`
 	ClientTimeout types.Int64  `tfsdk:"client_timeout"`
 	CaCert        types.String `tfsdk:"ca_cert"`
+	RetryMax      types.Int64  `tfsdk:"retry_max"`
+	RetryWaitMin  types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax  types.Int64  `tfsdk:"retry_wait_max"`
+	UserAgent     types.String `tfsdk:"user_agent"`
+
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
@@ -77,7 +87,39 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		password = data.Password.Value
 	}
 
-	client, err := wikijs.NewWikijsClient(host, username, password, data.InitialSetup.Value, data.ClientTimeout.Value, data.CaCert.Value)
+	retryMax := wikijs.DefaultRetryMax
+	if !data.RetryMax.Null {
+		retryMax = int(data.RetryMax.Value)
+	}
+	retryWaitMin := wikijs.DefaultRetryWaitMin
+	if !data.RetryWaitMin.Null {
+		retryWaitMin = time.Duration(data.RetryWaitMin.Value) * time.Second
+	}
+	retryWaitMax := wikijs.DefaultRetryWaitMax
+	if !data.RetryWaitMax.Null {
+		retryWaitMax = time.Duration(data.RetryWaitMax.Value) * time.Second
+	}
+
+	if retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("retry_wait_min"), "Invalid Attribute Value",
+			fmt.Sprintf("retry_wait_min must not be greater than retry_wait_max, got %s and %s", retryWaitMin, retryWaitMax))
+		return
+	}
+
+	userAgent := "terraform-provider-wikijs/" + p.version
+	if !data.UserAgent.Null {
+		userAgent = data.UserAgent.Value
+	}
+
+	// The client outlives this request, so its log entries carry the request
+	// fields of ConfigureProvider, see NewTflogLogger.
+	client, err := wikijs.NewWikijsClient(host, username, password, data.InitialSetup.Value, data.ClientTimeout.Value, data.CaCert.Value,
+		wikijs.WithRetry(retryMax, retryWaitMin, retryWaitMax),
+		wikijs.WithUserAgent(userAgent),
+		wikijs.WithClientCertificate(data.ClientCert.Value, data.ClientKey.Value),
+		wikijs.WithInsecureSkipVerify(data.InsecureSkipVerify.Value),
+		wikijs.WithLogger(wikijs.NewTflogLogger(ctx)),
+	)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -89,17 +131,44 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 	p.client = client
 	p.configured = true
 
+	if version, ok := client.ServerVersion(); ok && !version.AtLeast(wikijs.MinimumVersion) {
+		resp.Diagnostics.AddWarning("Unsupported Wiki.js Version",
+			fmt.Sprintf("Wiki.js %s is older than %s, the oldest version the provider supports. Resources using GraphQL fields the server lacks will fail.",
+				version, wikijs.MinimumVersion))
+	}
 }
 
 func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		//"scaffolding_example": exampleResourceType{},
+		"wikijs_analytics_provider": analyticsProviderResourceType{},
+		"wikijs_asset":              assetResourceType{},
+		"wikijs_asset_folder":       assetFolderResourceType{},
+		"wikijs_comments_provider":  commentsProviderResourceType{},
+		"wikijs_locale":             localeResourceType{},
+		"wikijs_logging_target":     loggingTargetResourceType{},
+		"wikijs_mail_config":        mailConfigResourceType{},
+		"wikijs_navigation":         navigationResourceType{},
+		"wikijs_renderer":           rendererResourceType{},
+		"wikijs_search_engine":      searchEngineResourceType{},
+		"wikijs_security_config":    securityConfigResourceType{},
+		"wikijs_site_config":        siteConfigResourceType{},
+		"wikijs_ssl":                sslResourceType{},
+		"wikijs_storage_action":     storageActionResourceType{},
+		"wikijs_storage_target":     storageTargetResourceType{},
+		"wikijs_system_flags":       systemFlagsResourceType{},
+		"wikijs_tag":                tagResourceType{},
+		"wikijs_theme":              themeResourceType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
 		"wikijs_authentication_strategy": authenticationStrategyDataSourceType{},
+		"wikijs_locales":                 localesDataSourceType{},
+		"wikijs_renderers":               renderersDataSourceType{},
+		"wikijs_ssl":                     sslDataSourceType{},
+		"wikijs_system_info":             systemInfoDataSourceType{},
+		"wikijs_tags":                    tagsDataSourceType{},
 	}, nil
 }
 
@@ -138,6 +207,45 @@ func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostic
 				Type:                types.StringType,
 				Optional:            true,
 			},
+			"client_cert": {
+				MarkdownDescription: "Client certificate for mutual TLS, either PEM encoded or the path of a PEM file. Requires `client_key`",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"client_key": {
+				MarkdownDescription: "Private key of `client_cert`, either PEM encoded or the path of a PEM file",
+				Type:                types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": {
+				MarkdownDescription: "Skip verification of the wikijs server certificate (only for development purposes)",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"retry_max": {
+				MarkdownDescription: "Maximum number of retries for failed requests. Defaults to `5`",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Validators:          []tfsdk.AttributeValidator{int64AtLeast{min: 0}},
+			},
+			"retry_wait_min": {
+				MarkdownDescription: "Minimum time to wait between retries in seconds. Defaults to `1`",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Validators:          []tfsdk.AttributeValidator{int64AtLeast{min: 0}},
+			},
+			"retry_wait_max": {
+				MarkdownDescription: "Maximum time to wait between retries in seconds. Defaults to `3`",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Validators:          []tfsdk.AttributeValidator{int64AtLeast{min: 0}},
+			},
+			"user_agent": {
+				MarkdownDescription: "User-Agent header sent to wikijs. Defaults to `terraform-provider-wikijs/<version>`",
+				Type:                types.StringType,
+				Optional:            true,
+			},
 		},
 	}, nil
 }
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -20,7 +23,25 @@ var testAccProvider tfsdk.Provider
 var testAccProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 var clientConnOnce sync.Once
 
-func init() {
+// testServer is the fake Wiki.js the tests run against, nil when testing
+// against a real instance.
+var testServer *wikijstest.Server
+
+func TestMain(m *testing.M) {
+	// Without a Wiki.js instance to test against, run against an in-process fake.
+	if os.Getenv("WIKIJS_HOST") == "" {
+		testServer = wikijstest.NewServer()
+		os.Setenv("WIKIJS_HOST", testServer.URL)
+		os.Setenv("WIKIJS_USERNAME", wikijstest.DefaultAdminEmail)
+		os.Setenv("WIKIJS_PASSWORD", wikijstest.DefaultAdminPassword)
+		code := runTests(m)
+		testServer.Close()
+		os.Exit(code)
+	}
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
 	clientConnOnce.Do(func() {
 		wikijsClient, _ = wikijs.NewWikijsClient(os.Getenv("WIKIJS_HOST"), os.Getenv("WIKIJS_USERNAME"), os.Getenv("WIKIJS_PASSWORD"), true, 30, "")
 		testAccProvider = New("test", wikijsClient)()
@@ -28,6 +49,7 @@ func init() {
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"wikijs": providerserver.NewProtocol6WithError(testAccProvider),
 	}
+	return m.Run()
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -51,3 +73,49 @@ func TestAccInitialSetup(t *testing.T) {
 	}
 	wikijsClient.Cleanup()
 }
+
+func TestProviderRetryConfig(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := New("test", nil)().GetSchema(ctx)
+	schemaType := schema.TerraformType(ctx)
+
+	configure := func(config map[string]interface{}) []*tfprotov6.Diagnostic {
+		server, err := providerserver.NewProtocol6WithError(New("test", nil)())()
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := tfprotov6.NewDynamicValue(schemaType, toTerraformValue(t, schemaType, config))
+		if err != nil {
+			t.Fatal(err)
+		}
+		validated, err := server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{Config: &value})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hasError(validated.Diagnostics) {
+			return validated.Diagnostics
+		}
+		configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &value})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return configured.Diagnostics
+	}
+
+	for _, name := range []string{"retry_max", "retry_wait_min", "retry_wait_max"} {
+		if diags := configure(map[string]interface{}{name: -1}); !hasError(diags) {
+			t.Errorf("expected negative %s to be invalid", name)
+		}
+	}
+
+	diags := configure(map[string]interface{}{"retry_wait_min": 5})
+	if !hasError(diags) || !strings.Contains(diags[0].Detail, "retry_wait_min must not be greater than retry_wait_max") {
+		t.Errorf("expected retry_wait_min above the default retry_wait_max to be invalid, got %v", diags)
+	}
+
+	if testServer != nil {
+		if diags := configure(map[string]interface{}{"retry_max": 0, "retry_wait_min": 2, "retry_wait_max": 2}); hasError(diags) {
+			t.Errorf("configure: %v", diags)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type analyticsProviderResourceType struct{}
+
+func (t analyticsProviderResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"key": {
+			MarkdownDescription: "Key of the analytics provider, e.g. `google`, `matomo` or `plausible`",
+			Type:                types.StringType,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"title": {
+			MarkdownDescription: "Title of the analytics provider",
+			Type:                types.StringType,
+			Computed:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"is_enabled": {
+			MarkdownDescription: "Whether the analytics provider is enabled",
+			Type:                types.BoolType,
+			Required:            true,
+		},
+	}
+	for name, attribute := range moduleConfigAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Analytics provider which adds its tracking code to all pages. Destroying the resource disables the provider.",
+		Attributes:          attributes,
+	}, nil
+}
+
+func (t analyticsProviderResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return analyticsProviderResource{
+		provider: provider,
+	}, diags
+}
+
+type analyticsProviderResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	Config          types.Map    `tfsdk:"config"`
+	SensitiveConfig types.Map    `tfsdk:"sensitive_config"`
+}
+
+func newAnalyticsProviderResourceData(provider module, prior analyticsProviderResourceData) (analyticsProviderResourceData, error) {
+	properties, err := wikijs.DecodeModuleConfig(provider.Config)
+	if err != nil {
+		return prior, err
+	}
+
+	return analyticsProviderResourceData{
+		Id:              types.String{Value: provider.Key},
+		Key:             types.String{Value: provider.Key},
+		Title:           types.String{Value: provider.Title},
+		IsEnabled:       types.Bool{Value: provider.IsEnabled},
+		Config:          newModuleConfig(properties, prior.Config),
+		SensitiveConfig: prior.SensitiveConfig,
+	}, nil
+}
+
+// analyticsProviderModules returns the analytics providers as module list.
+func analyticsProviderModules(client *wikijs.WikijsClient) moduleList {
+	return moduleList{
+		name: "analytics provider",
+		get: func() ([]module, error) {
+			providers, err := client.GetAnalyticsProviders()
+			if err != nil {
+				return nil, err
+			}
+			modules := []module{}
+			for _, provider := range providers {
+				modules = append(modules, module{Key: provider.Key, Title: provider.Title, IsEnabled: provider.IsEnabled, Config: provider.Config})
+			}
+			return modules, nil
+		},
+		update: func(modules []module) error {
+			inputs := []wikijs.AnalyticsProviderInput{}
+			for _, module := range modules {
+				inputs = append(inputs, wikijs.AnalyticsProviderInput{IsEnabled: module.IsEnabled, Key: module.Key, Config: module.Config})
+			}
+			return client.UpdateAnalyticsProviders(inputs)
+		},
+	}
+}
+
+type analyticsProviderResource struct {
+	provider provider
+}
+
+func (r analyticsProviderResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data analyticsProviderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r analyticsProviderResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data analyticsProviderResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.Value
+	if data.Key.Null {
+		// imported by key
+		key = data.Id.Value
+	}
+
+	provider, diags := analyticsProviderModules(r.provider.client).read(key)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := newAnalyticsProviderResourceData(*provider, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read analytics provider, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r analyticsProviderResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data analyticsProviderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r analyticsProviderResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data analyticsProviderResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Analytics providers cannot be deleted, so the provider is disabled.
+	resp.Diagnostics.Append(analyticsProviderModules(r.provider.client).disable(data.Key.Value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r analyticsProviderResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+func (r analyticsProviderResource) apply(ctx context.Context, data analyticsProviderResourceData, state *tfsdk.State) diag.Diagnostics {
+	provider, diags := analyticsProviderModules(r.provider.client).apply(ctx, data.Key.Value, data.IsEnabled.Value, data.Config, data.SensitiveConfig)
+	if diags.HasError() {
+		return diags
+	}
+
+	data, err := newAnalyticsProviderResourceData(*provider, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read analytics provider, got error: %s", err))
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
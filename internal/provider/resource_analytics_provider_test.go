@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAnalyticsProviderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAnalyticsProviderResourceConfig("wiki.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_analytics_provider.test", "id", "plausible"),
+					resource.TestCheckResourceAttr("wikijs_analytics_provider.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("wikijs_analytics_provider.test", "config.domain", "wiki.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_analytics_provider.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAnalyticsProviderResourceConfig("docs.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_analytics_provider.test", "config.domain", "docs.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnalyticsProviderResourceConfig(domain string) string {
+	return `
+resource "wikijs_analytics_provider" "test" {
+	key        = "plausible"
+	is_enabled = true
+	config = {
+		domain = "` + domain + `"
+	}
+}
+`
+}
+
+func TestAnalyticsProviderResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_analytics_provider")
+
+	config := map[string]interface{}{
+		"key":        "matomo",
+		"is_enabled": true,
+		"config": map[string]string{
+			"siteId":     "7",
+			"serverHost": "https://matomo.example.com",
+		},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if h.attributes()["title"] != "Matomo" {
+		t.Errorf("title = %v, want Matomo", h.attributes()["title"])
+	}
+	values := analyticsProviderConfig(t, "matomo")
+	if values["siteId"] != 7.0 || values["serverHost"] != "https://matomo.example.com" {
+		t.Errorf("unexpected config %v", values)
+	}
+
+	// Other providers are kept and can be enabled alongside.
+	other := newResourceHarness(t, "wikijs_analytics_provider")
+	if diags := other.apply(map[string]interface{}{"key": "plausible", "is_enabled": true}); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	providers, err := wikijsClient.GetAnalyticsProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := []string{}
+	for _, provider := range providers {
+		if provider.IsEnabled {
+			enabled = append(enabled, provider.Key)
+		}
+	}
+	if !reflect.DeepEqual(enabled, []string{"matomo", "plausible"}) {
+		t.Errorf("enabled providers = %v, want [matomo plausible]", enabled)
+	}
+	if diags := other.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Config changed in Wiki.js is detected.
+	providers, err = wikijsClient.GetAnalyticsProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs, err := wikijs.AnalyticsProviderInputs(providers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range inputs {
+		if inputs[i].Key == "matomo" {
+			values["siteId"] = 8
+			inputs[i].Config, _ = wikijs.EncodeModuleConfig(values)
+		}
+	}
+	if err := wikijsClient.UpdateAnalyticsProviders(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if siteId := h.attributes()["config"].(map[string]interface{})["siteId"]; siteId != "8" {
+		t.Errorf("drift not detected, siteId is %v", siteId)
+	}
+
+	invalid := []map[string]interface{}{
+		{"key": "matomo", "is_enabled": true, "config": map[string]string{"siteId": "seven"}},
+		{"key": "matomo", "is_enabled": true, "config": map[string]string{"trackingId": "UA-1"}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+	if diags := newResourceHarness(t, "wikijs_analytics_provider").apply(map[string]interface{}{"key": "webtrends", "is_enabled": true}); !hasError(diags) {
+		t.Errorf("expected unknown provider to be invalid")
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	providers, err = wikijsClient.GetAnalyticsProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, provider := range providers {
+		if provider.IsEnabled {
+			t.Errorf("expected %s to be disabled after destroy", provider.Key)
+		}
+	}
+}
+
+func analyticsProviderConfig(t *testing.T, key string) map[string]interface{} {
+	providers, err := wikijsClient.GetAnalyticsProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, provider := range providers {
+		if provider.Key != key {
+			continue
+		}
+		properties, err := wikijs.DecodeModuleConfig(provider.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return wikijs.ModuleConfigValues(properties)
+	}
+	t.Fatalf("analytics provider %s does not exist", key)
+	return nil
+}
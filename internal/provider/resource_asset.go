@@ -0,0 +1,411 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// assetFilename matches filenames Wiki.js keeps as they are on upload, it
+// lowercases them and replaces whitespace, commas, semicolons and hashes.
+var assetFilename = regexp.MustCompile(`^[^A-Z\s,;#/\\]+$`)
+
+type assetResourceType struct{}
+
+func (t assetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "File uploaded to the asset manager, e.g. an image or PDF referenced by pages. " +
+			"The file is uploaded again whenever its content changes.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"folder_id": {
+				MarkdownDescription: "Id of the folder, see `wikijs_asset_folder`. Defaults to `0`, the root folder",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"filename": {
+				MarkdownDescription: "Filename of the asset. Renaming keeps the asset, changing the extension replaces it",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringMatches{pattern: assetFilename, description: "a lowercase filename without whitespace, commas, semicolons, hashes or slashes"},
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplaceIf(extensionChanged, "Changing the file extension replaces the asset.", "Changing the file extension replaces the asset."),
+				},
+			},
+			"source": {
+				MarkdownDescription: "Path of the local file to upload",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"content_hash": {
+				MarkdownDescription: "SHA256 hash of the uploaded content",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"file_size": {
+				MarkdownDescription: "Size of the asset in bytes",
+				Type:                types.Int64Type,
+				Computed:            true,
+			},
+			"mime": {
+				MarkdownDescription: "MIME type of the asset, e.g. `image/png`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"path": {
+				MarkdownDescription: "Path the asset is served at, e.g. `/runbooks/diagram.png`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t assetResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return assetResource{
+		provider: provider,
+	}, diags
+}
+
+type assetResourceData struct {
+	Id          types.String `tfsdk:"id"`
+	FolderId    types.Int64  `tfsdk:"folder_id"`
+	Filename    types.String `tfsdk:"filename"`
+	Source      types.String `tfsdk:"source"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	FileSize    types.Int64  `tfsdk:"file_size"`
+	Mime        types.String `tfsdk:"mime"`
+	Path        types.String `tfsdk:"path"`
+}
+
+// newAssetResourceData returns the state of asset in folder. The content hash
+// is kept from prior unless the size shows that the content was changed
+// outside of Terraform, in which case it is cleared to upload the file again.
+func newAssetResourceData(asset wikijs.Asset, folder assetFolderNode, prior assetResourceData) assetResourceData {
+	assetPath := "/" + asset.Filename
+	if folder.path != "" {
+		assetPath = "/" + folder.path + assetPath
+	}
+	contentHash := prior.ContentHash
+	if !prior.FileSize.Null && !prior.FileSize.Unknown && prior.FileSize.Value != asset.FileSize {
+		contentHash = types.String{Value: ""}
+	}
+
+	return assetResourceData{
+		Id:          types.String{Value: strconv.FormatInt(asset.Id, 10)},
+		FolderId:    types.Int64{Value: folder.folder.Id},
+		Filename:    types.String{Value: asset.Filename},
+		Source:      prior.Source,
+		ContentHash: contentHash,
+		FileSize:    types.Int64{Value: asset.FileSize},
+		Mime:        types.String{Value: asset.Mime},
+		Path:        types.String{Value: assetPath},
+	}
+}
+
+// extensionChanged requires replacement when the file extension changes, as
+// Wiki.js cannot rename assets to another extension.
+func extensionChanged(ctx context.Context, state, config attr.Value, attributePath *tftypes.AttributePath) (bool, diag.Diagnostics) {
+	stateValue, ok := state.(types.String)
+	if !ok || stateValue.Null || stateValue.Unknown {
+		return false, nil
+	}
+	configValue, ok := config.(types.String)
+	if !ok || configValue.Null || configValue.Unknown {
+		return false, nil
+	}
+	return path.Ext(stateValue.Value) != path.Ext(configValue.Value), nil
+}
+
+// sourceHash returns the SHA256 hash of the file to upload.
+func sourceHash(source string) (string, error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// findAsset returns the asset with id and its folder. The asset is searched
+// in the folder with folderId, or in all folders if it is nil, e.g. on
+// import. It returns nil if the asset does not exist.
+func findAsset(client *wikijs.WikijsClient, folderId *int64, id int64) (*wikijs.Asset, assetFolderNode, error) {
+	nodes, err := assetFolderTree(client)
+	if err != nil {
+		return nil, assetFolderNode{}, err
+	}
+	for _, node := range nodes {
+		if folderId != nil && node.folder.Id != *folderId {
+			continue
+		}
+		assets, err := client.GetAssets(node.folder.Id, wikijs.AssetKindAll)
+		if err != nil {
+			return nil, assetFolderNode{}, err
+		}
+		for _, asset := range assets {
+			if asset.Id == id {
+				return &asset, node, nil
+			}
+		}
+	}
+	return nil, assetFolderNode{}, nil
+}
+
+type assetResource struct {
+	provider provider
+}
+
+// ModifyPlan plans the hash of the source file, so that changed content is
+// uploaded again.
+func (r assetResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state assetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() || data.Source.Unknown {
+		return
+	}
+
+	contentHash, err := sourceHash(data.Source.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("source"), "Unreadable Source",
+			fmt.Sprintf("Unable to read %s, got error: %s", data.Source.Value, err))
+		return
+	}
+	data.ContentHash = types.String{Value: contentHash}
+	if state.ContentHash.Value != contentHash {
+		data.FileSize = types.Int64{Unknown: true}
+	}
+
+	diags = resp.Plan.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r assetResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data assetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folderId := int64(wikijs.RootAssetFolderId)
+	if value := int64Pointer(data.FolderId); value != nil {
+		folderId = *value
+	}
+
+	// Uploading replaces an asset with the same filename, which should be
+	// imported instead of being overwritten.
+	assets, err := r.provider.client.GetAssets(folderId, wikijs.AssetKindAll)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read assets, got error: %s", err))
+		return
+	}
+	for _, asset := range assets {
+		if asset.Filename == data.Filename.Value {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("filename"), "Asset Already Exists",
+				fmt.Sprintf("Asset %s already exists in folder %d, import it with its id %d to manage it", asset.Filename, folderId, asset.Id))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.upload(ctx, data, folderId, nil, &resp.State)...)
+}
+
+func (r assetResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data assetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Asset id must be a number, got: %s", data.Id.Value))
+		return
+	}
+
+	// imported assets are searched in all folders
+	asset, folder, err := findAsset(r.provider.client, int64Pointer(data.FolderId), id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read asset, got error: %s", err))
+		return
+	}
+	if asset == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = newAssetResourceData(*asset, folder, data)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r assetResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data, state assetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Asset id must be a number, got: %s", state.Id.Value))
+		return
+	}
+
+	if data.Filename.Value != state.Filename.Value {
+		err = r.provider.client.RenameAsset(id, data.Filename.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename asset, got error: %s", err))
+			return
+		}
+	}
+
+	if data.ContentHash.Value == state.ContentHash.Value {
+		asset, folder, err := findAsset(r.provider.client, &state.FolderId.Value, id)
+		if err != nil || asset == nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read asset, got error: %v", err))
+			return
+		}
+		data = newAssetResourceData(*asset, folder, data)
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.upload(ctx, data, state.FolderId.Value, &id, &resp.State)...)
+}
+
+func (r assetResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data assetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Asset id must be a number, got: %s", data.Id.Value))
+		return
+	}
+
+	err = r.provider.client.DeleteAsset(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete asset, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r assetResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// upload uploads the source file into the folder and sets the state to the
+// uploaded asset. An existing asset with id keeps its id, as Wiki.js replaces
+// assets with the same filename.
+func (r assetResource) upload(ctx context.Context, data assetResourceData, folderId int64, id *int64, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	contentHash, err := sourceHash(data.Source.Value)
+	if err != nil {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("source"), "Unreadable Source",
+			fmt.Sprintf("Unable to read %s, got error: %s", data.Source.Value, err))
+		return diags
+	}
+
+	err = r.provider.client.UploadAssetFile(folderId, data.Filename.Value, data.Source.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upload asset, got error: %s", err))
+		return diags
+	}
+
+	assets, err := r.provider.client.GetAssets(folderId, wikijs.AssetKindAll)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read assets, got error: %s", err))
+		return diags
+	}
+	var uploaded *wikijs.Asset
+	for i := range assets {
+		if assets[i].Filename == data.Filename.Value && (id == nil || assets[i].Id == *id) {
+			uploaded = &assets[i]
+		}
+	}
+	if uploaded == nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find asset %s after uploading it", data.Filename.Value))
+		return diags
+	}
+
+	nodes, err := assetFolderTree(r.provider.client)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read asset folders, got error: %s", err))
+		return diags
+	}
+
+	data.ContentHash = types.String{Value: contentHash}
+	data.FileSize = types.Int64{Null: true}
+	data = newAssetResourceData(*uploaded, nodes[folderId], data)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var assetFolderSlug = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+type assetFolderResourceType struct{}
+
+func (t assetFolderResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Folder of the asset manager. Wiki.js cannot rename or delete folders, so changes replace the folder " +
+			"and destroying the resource leaves it in Wiki.js.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"folder_id": {
+				MarkdownDescription: "Id of the folder, used as `folder_id` of assets and `parent_folder_id` of sub folders",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"parent_folder_id": {
+				MarkdownDescription: "Id of the parent folder. Defaults to `0`, the root folder",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"slug": {
+				MarkdownDescription: "Slug of the folder, used in the path of its assets",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringMatches{pattern: assetFolderSlug, description: "lowercase letters, digits, dashes and underscores"},
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of the folder shown in the asset manager, Wiki.js sets it to the slug",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"path": {
+				MarkdownDescription: "Path of the folder below the root folder, e.g. `runbooks/diagrams`",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t assetFolderResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return assetFolderResource{
+		provider: provider,
+	}, diags
+}
+
+type assetFolderResourceData struct {
+	Id             types.String `tfsdk:"id"`
+	FolderId       types.Int64  `tfsdk:"folder_id"`
+	ParentFolderId types.Int64  `tfsdk:"parent_folder_id"`
+	Slug           types.String `tfsdk:"slug"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+}
+
+func newAssetFolderResourceData(node assetFolderNode) assetFolderResourceData {
+	return assetFolderResourceData{
+		Id:             types.String{Value: strconv.FormatInt(node.folder.Id, 10)},
+		FolderId:       types.Int64{Value: node.folder.Id},
+		ParentFolderId: types.Int64{Value: node.parentId},
+		Slug:           types.String{Value: node.folder.Slug},
+		Name:           types.String{Value: node.folder.Name},
+		Path:           types.String{Value: node.path},
+	}
+}
+
+// assetFolderNode is an asset folder with the id of its parent and its path
+// below the root folder.
+type assetFolderNode struct {
+	folder   wikijs.AssetFolder
+	parentId int64
+	path     string
+}
+
+// assetFolderTree returns all asset folders by id, including the root folder
+// with an empty path. Wiki.js only lists the folders of a single parent, so
+// the tree is walked from the root folder.
+func assetFolderTree(client *wikijs.WikijsClient) (map[int64]assetFolderNode, error) {
+	nodes := map[int64]assetFolderNode{
+		wikijs.RootAssetFolderId: {folder: wikijs.AssetFolder{Id: wikijs.RootAssetFolderId}},
+	}
+	parents := []int64{wikijs.RootAssetFolderId}
+	for len(parents) > 0 {
+		parentId := parents[0]
+		parents = parents[1:]
+		folders, err := client.GetAssetFolders(parentId)
+		if err != nil {
+			return nil, err
+		}
+		for _, folder := range folders {
+			path := folder.Slug
+			if parentPath := nodes[parentId].path; parentPath != "" {
+				path = parentPath + "/" + folder.Slug
+			}
+			nodes[folder.Id] = assetFolderNode{folder: folder, parentId: parentId, path: path}
+			parents = append(parents, folder.Id)
+		}
+	}
+	return nodes, nil
+}
+
+type assetFolderResource struct {
+	provider provider
+}
+
+func (r assetFolderResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data assetFolderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentId := int64(wikijs.RootAssetFolderId)
+	if value := int64Pointer(data.ParentFolderId); value != nil {
+		parentId = *value
+	}
+	err := r.provider.client.CreateAssetFolder(parentId, data.Slug.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create asset folder, got error: %s", err))
+		return
+	}
+
+	// Wiki.js does not return the new folder, so it is looked up by slug.
+	nodes, err := assetFolderTree(r.provider.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read asset folders, got error: %s", err))
+		return
+	}
+	for _, node := range nodes {
+		if node.parentId == parentId && node.folder.Slug == data.Slug.Value && node.folder.Id != wikijs.RootAssetFolderId {
+			data = newAssetFolderResourceData(node)
+			diags = resp.State.Set(ctx, &data)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find asset folder %s after creating it", data.Slug.Value))
+}
+
+func (r assetFolderResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data assetFolderResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Asset folder id must be a number, got: %s", data.Id.Value))
+		return
+	}
+
+	nodes, err := assetFolderTree(r.provider.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read asset folders, got error: %s", err))
+		return
+	}
+	node, ok := nodes[id]
+	if !ok || id == wikijs.RootAssetFolderId {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = newAssetFolderResourceData(node)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes, as all attributes require replacement.
+func (r assetFolderResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data assetFolderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the folder in Wiki.js, which has no API to delete folders.
+func (r assetFolderResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func (r assetFolderResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAssetFolderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssetFolderResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_asset_folder.runbooks", "path", "tf-acc-runbooks"),
+					resource.TestCheckResourceAttr("wikijs_asset_folder.diagrams", "path", "tf-acc-runbooks/diagrams"),
+					resource.TestCheckResourceAttr("wikijs_asset_folder.diagrams", "name", "diagrams"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "wikijs_asset_folder.diagrams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccAssetFolderResourceConfig = `
+resource "wikijs_asset_folder" "runbooks" {
+	slug = "tf-acc-runbooks"
+}
+
+resource "wikijs_asset_folder" "diagrams" {
+	parent_folder_id = wikijs_asset_folder.runbooks.folder_id
+	slug             = "diagrams"
+}
+`
+
+func TestAssetFolderResource(t *testing.T) {
+	parent := newResourceHarness(t, "wikijs_asset_folder")
+	if diags := parent.apply(map[string]interface{}{"slug": "folders"}); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	attributes := parent.attributes()
+	if attributes["path"] != "folders" || attributes["name"] != "folders" {
+		t.Errorf("unexpected attributes %v", attributes)
+	}
+	if parentId := attributes["parent_folder_id"].(*big.Float); parentId.Sign() != 0 {
+		t.Errorf("parent_folder_id = %v, want 0", parentId)
+	}
+	folderId, _ := attributes["folder_id"].(*big.Float).Int64()
+
+	h := newResourceHarness(t, "wikijs_asset_folder")
+	config := map[string]interface{}{
+		"parent_folder_id": folderId,
+		"slug":             "diagrams",
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if path := h.attributes()["path"]; path != "folders/diagrams" {
+		t.Errorf("path = %v, want folders/diagrams", path)
+	}
+	if name := h.attributes()["name"]; name != "diagrams" {
+		t.Errorf("name = %v, want the slug diagrams", name)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if len(plan.RequiresReplace) > 0 {
+		t.Errorf("expected no replacement, got %v", plan.RequiresReplace)
+	}
+
+	imported := newResourceHarness(t, "wikijs_asset_folder")
+	if diags := imported.importState(h.attributes()["id"].(string)); hasError(diags) {
+		t.Fatalf("import: %v", diags)
+	}
+	if imported.attributes()["path"] != "folders/diagrams" || imported.attributes()["slug"] != "diagrams" {
+		t.Errorf("unexpected imported attributes %v", imported.attributes())
+	}
+
+	// A folder with the same slug cannot be created twice.
+	if diags := newResourceHarness(t, "wikijs_asset_folder").apply(config); !hasError(diags) {
+		t.Errorf("expected duplicate folder to fail")
+	}
+	if diags := newResourceHarness(t, "wikijs_asset_folder").apply(map[string]interface{}{"slug": "Diagrams"}); !hasError(diags) {
+		t.Errorf("expected invalid slug to fail")
+	}
+	if diags := newResourceHarness(t, "wikijs_asset_folder").apply(map[string]interface{}{"slug": "names", "name": "Names"}); !hasError(diags) {
+		t.Errorf("expected configured name to fail")
+	}
+
+	// Folders cannot be deleted, destroying only removes them from state.
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	folders, err := wikijsClient.GetAssetFolders(folderId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(folders) != 1 {
+		t.Errorf("expected folder to be kept, got %v", folders)
+	}
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAssetResource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "diagram.svg")
+	if err := ioutil.WriteFile(source, []byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssetResourceConfig(source, "tf-acc-diagram.svg"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_asset.test", "path", "/tf-acc-assets/tf-acc-diagram.svg"),
+					resource.TestCheckResourceAttr("wikijs_asset.test", "mime", "image/svg+xml"),
+					resource.TestCheckResourceAttr("wikijs_asset.test", "file_size", "41"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_asset.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "content_hash"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAssetResourceConfig(source, "tf-acc-renamed.svg"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_asset.test", "path", "/tf-acc-assets/tf-acc-renamed.svg"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetResourceConfig(source, filename string) string {
+	return `
+resource "wikijs_asset_folder" "test" {
+	slug = "tf-acc-assets"
+}
+
+resource "wikijs_asset" "test" {
+	folder_id = wikijs_asset_folder.test.folder_id
+	filename  = "` + filename + `"
+	source    = "` + source + `"
+}
+`
+}
+
+func TestAssetResource(t *testing.T) {
+	if testServer == nil {
+		t.Skip("checking uploaded content needs the fake Wiki.js server")
+	}
+	source := filepath.Join(t.TempDir(), "diagram.png")
+	writeSource := func(content string) {
+		if err := ioutil.WriteFile(source, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("\x89PNG first")
+
+	folder := newResourceHarness(t, "wikijs_asset_folder")
+	if diags := folder.apply(map[string]interface{}{"slug": "runbooks"}); hasError(diags) {
+		t.Fatalf("create folder: %v", diags)
+	}
+	folderId, _ := folder.attributes()["folder_id"].(*big.Float).Int64()
+
+	h := newResourceHarness(t, "wikijs_asset")
+	config := map[string]interface{}{
+		"folder_id": folderId,
+		"filename":  "diagram.png",
+		"source":    source,
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	attributes := h.attributes()
+	if attributes["path"] != "/runbooks/diagram.png" || attributes["mime"] != "image/png" {
+		t.Errorf("unexpected attributes %v", attributes)
+	}
+	if content, _ := testServer.AssetContent(folderId, "diagram.png"); string(content) != "\x89PNG first" {
+		t.Errorf("unexpected content %q", content)
+	}
+	id := attributes["id"]
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Changed content is uploaded again, keeping the asset.
+	writeSource("\x89PNG second version")
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if content, _ := testServer.AssetContent(folderId, "diagram.png"); string(content) != "\x89PNG second version" {
+		t.Errorf("content not uploaded again, got %q", content)
+	}
+	if size, _ := h.attributes()["file_size"].(*big.Float).Int64(); size != 19 || h.attributes()["id"] != id {
+		t.Errorf("unexpected attributes after upload %v", h.attributes())
+	}
+
+	// Renaming keeps the asset, changing the extension replaces it.
+	config["filename"] = "runbook.png"
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("rename: %v", diags)
+	}
+	if h.attributes()["id"] != id || h.attributes()["path"] != "/runbooks/runbook.png" {
+		t.Errorf("unexpected attributes after rename %v", h.attributes())
+	}
+	config["filename"] = "runbook.bin"
+	if plan := h.planOnly(config); len(plan.RequiresReplace) == 0 {
+		t.Errorf("expected extension change to require replacement")
+	}
+	config["filename"] = "runbook.png"
+
+	// Content changed in Wiki.js is detected by its size and uploaded again.
+	if err := wikijsClient.UploadAsset(folderId, "runbook.png", []byte("changed")); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if plan := h.planOnly(config); reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected changed content to be planned for upload")
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if content, _ := testServer.AssetContent(folderId, "runbook.png"); string(content) != "\x89PNG second version" {
+		t.Errorf("content not restored, got %q", content)
+	}
+
+	imported := newResourceHarness(t, "wikijs_asset")
+	if diags := imported.importState(id.(string)); hasError(diags) {
+		t.Fatalf("import: %v", diags)
+	}
+	if imported.attributes()["path"] != "/runbooks/runbook.png" {
+		t.Errorf("unexpected imported attributes %v", imported.attributes())
+	}
+
+	invalid := []map[string]interface{}{
+		{"filename": "Diagram.png", "source": source},
+		{"filename": "my diagram.png", "source": source},
+		{"filename": "diagram.png", "source": filepath.Join(t.TempDir(), "missing.png")},
+		// exists already
+		{"folder_id": folderId, "filename": "runbook.png", "source": source},
+	}
+	for _, config := range invalid {
+		if diags := newResourceHarness(t, "wikijs_asset").apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	assets, err := wikijsClient.GetAssets(folderId, wikijs.AssetKindAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assets) != 0 {
+		t.Errorf("expected asset to be deleted, got %v", assets)
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const commentsProviderId = "comments_provider"
+
+// defaultCommentsProvider is the built-in provider Wiki.js uses out of the
+// box, which is activated again when the resource is destroyed.
+const defaultCommentsProvider = "default"
+
+type commentsProviderResourceType struct{}
+
+func (t commentsProviderResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"key": {
+			MarkdownDescription: "Key of the active comments provider, e.g. `default` for the built-in comments, `commento` or `disqus`",
+			Type:                types.StringType,
+			Required:            true,
+		},
+		"title": {
+			MarkdownDescription: "Title of the comments provider",
+			Type:                types.StringType,
+			Computed:            true,
+		},
+	}
+	for name, attribute := range moduleConfigAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Active comments provider and its config. All other providers are disabled, as Wiki.js only uses one. " +
+			"Comments are turned on and off with `feature_page_comments` of `wikijs_site_config`. " +
+			"Destroying the resource activates the built-in `default` provider again.",
+		Attributes: attributes,
+	}, nil
+}
+
+func (t commentsProviderResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return commentsProviderResource{
+		provider: provider,
+	}, diags
+}
+
+type commentsProviderResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	Config          types.Map    `tfsdk:"config"`
+	SensitiveConfig types.Map    `tfsdk:"sensitive_config"`
+}
+
+func newCommentsProviderResourceData(provider module, prior commentsProviderResourceData) (commentsProviderResourceData, error) {
+	properties, err := wikijs.DecodeModuleConfig(provider.Config)
+	if err != nil {
+		return prior, err
+	}
+
+	return commentsProviderResourceData{
+		Id:              types.String{Value: commentsProviderId},
+		Key:             types.String{Value: provider.Key},
+		Title:           types.String{Value: provider.Title},
+		Config:          newModuleConfig(properties, prior.Config),
+		SensitiveConfig: prior.SensitiveConfig,
+	}, nil
+}
+
+// commentsProviderModules returns the comments providers as module list.
+func commentsProviderModules(client *wikijs.WikijsClient) moduleList {
+	return moduleList{
+		name:   "comments provider",
+		single: true,
+		get: func() ([]module, error) {
+			providers, err := client.GetCommentProviders()
+			if err != nil {
+				return nil, err
+			}
+			modules := []module{}
+			for _, provider := range providers {
+				modules = append(modules, module{Key: provider.Key, Title: provider.Title, IsEnabled: provider.IsEnabled, Config: provider.Config})
+			}
+			return modules, nil
+		},
+		update: func(modules []module) error {
+			inputs := []wikijs.CommentProviderInput{}
+			for _, module := range modules {
+				inputs = append(inputs, wikijs.CommentProviderInput{IsEnabled: module.IsEnabled, Key: module.Key, Config: module.Config})
+			}
+			return client.UpdateCommentProviders(inputs)
+		},
+	}
+}
+
+type commentsProviderResource struct {
+	provider provider
+}
+
+func (r commentsProviderResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data commentsProviderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r commentsProviderResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data commentsProviderResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, diags := commentsProviderModules(r.provider.client).read("")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if provider == nil {
+		// No provider is active, the cleared key plans to activate it again.
+		data.Key = types.String{Value: ""}
+		data.Title = types.String{Value: ""}
+	} else {
+		var err error
+		data, err = newCommentsProviderResourceData(*provider, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read comments provider, got error: %s", err))
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r commentsProviderResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data commentsProviderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r commentsProviderResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.Diagnostics.Append(commentsProviderModules(r.provider.client).activate(defaultCommentsProvider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r commentsProviderResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply enables the provider and disables all others.
+func (r commentsProviderResource) apply(ctx context.Context, data commentsProviderResourceData, state *tfsdk.State) diag.Diagnostics {
+	provider, diags := commentsProviderModules(r.provider.client).apply(ctx, data.Key.Value, true, data.Config, data.SensitiveConfig)
+	if diags.HasError() {
+		return diags
+	}
+
+	data, err := newCommentsProviderResourceData(*provider, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read comments provider, got error: %s", err))
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCommentsProviderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCommentsProviderResourceConfig("45"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_comments_provider.test", "id", "comments_provider"),
+					resource.TestCheckResourceAttr("wikijs_comments_provider.test", "key", "default"),
+					resource.TestCheckResourceAttr("wikijs_comments_provider.test", "config.minDelay", "45"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_comments_provider.test",
+				ImportState:             true,
+				ImportStateId:           "comments_provider",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCommentsProviderResourceConfig("60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_comments_provider.test", "config.minDelay", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCommentsProviderResourceConfig(minDelay string) string {
+	return `
+resource "wikijs_comments_provider" "test" {
+	key = "default"
+	config = {
+		minDelay = "` + minDelay + `"
+	}
+}
+`
+}
+
+func TestCommentsProviderResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_comments_provider")
+
+	config := map[string]interface{}{
+		"key":              "default",
+		"config":           map[string]string{"minDelay": "45"},
+		"sensitive_config": map[string]string{"akismet": "s3cret"},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if h.attributes()["title"] != "Default" {
+		t.Errorf("title = %v, want Default", h.attributes()["title"])
+	}
+	values := commentProviderConfig(t, "default")
+	if values["minDelay"] != 45.0 || values["akismet"] != "s3cret" {
+		t.Errorf("unexpected config %v", values)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Switching the provider disables the others.
+	config = map[string]interface{}{
+		"key":    "disqus",
+		"config": map[string]string{"accountName": "example-wiki"},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if enabled := enabledCommentProviders(t); !reflect.DeepEqual(enabled, []string{"disqus"}) {
+		t.Errorf("enabled providers = %v, want [disqus]", enabled)
+	}
+
+	// Another provider activated in Wiki.js is detected.
+	providers, err := wikijsClient.GetCommentProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs, err := wikijs.CommentProviderInputs(providers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range inputs {
+		inputs[i].IsEnabled = inputs[i].Key == "commento"
+	}
+	if err := wikijsClient.UpdateCommentProviders(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if key := h.attributes()["key"]; key != "commento" {
+		t.Errorf("drift not detected, key is %v", key)
+	}
+
+	// With all providers disabled in Wiki.js, the provider is activated again.
+	for i := range inputs {
+		inputs[i].IsEnabled = false
+	}
+	if err := wikijsClient.UpdateCommentProviders(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if key := h.attributes()["key"]; key != "" {
+		t.Errorf("inactive provider not detected, key is %v", key)
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("activate: %v", diags)
+	}
+	if enabled := enabledCommentProviders(t); !reflect.DeepEqual(enabled, []string{"disqus"}) {
+		t.Errorf("enabled providers = %v, want [disqus]", enabled)
+	}
+
+	invalid := []map[string]interface{}{
+		{"key": "facebook"},
+		{"key": "default", "config": map[string]string{"akismet": "visible"}},
+		{"key": "default", "config": map[string]string{"minDelay": "soon"}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	if enabled := enabledCommentProviders(t); !reflect.DeepEqual(enabled, []string{"default"}) {
+		t.Errorf("enabled providers after destroy = %v, want [default]", enabled)
+	}
+}
+
+func commentProviderConfig(t *testing.T, key string) map[string]interface{} {
+	providers, err := wikijsClient.GetCommentProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, provider := range providers {
+		if provider.Key != key {
+			continue
+		}
+		properties, err := wikijs.DecodeModuleConfig(provider.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return wikijs.ModuleConfigValues(properties)
+	}
+	t.Fatalf("comments provider %s does not exist", key)
+	return nil
+}
+
+func enabledCommentProviders(t *testing.T) []string {
+	providers, err := wikijsClient.GetCommentProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := []string{}
+	for _, provider := range providers {
+		if provider.IsEnabled {
+			enabled = append(enabled, provider.Key)
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// localeId is the id of the singleton localization configuration.
+const localeId = "locale"
+
+// defaultLocaleInstallTimeout is the default time to wait for a downloaded
+// locale to be installed.
+const defaultLocaleInstallTimeout = 300
+
+type localeResourceType struct{}
+
+func (t localeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Site locale and locale namespaces. The site locale, all namespaces and `installed_locales` are downloaded and installed if they are not installed yet. " +
+			"Destroying the resource leaves the settings and installed locales as they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"locale": {
+				MarkdownDescription: "Locale of the site, e.g. `en`",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"auto_update": settingAttribute("Automatically download updates of installed locales", types.BoolType),
+			"namespacing": settingAttribute("Organize content in a namespace per locale, e.g. `/fr/page`", types.BoolType),
+			"namespaces":  settingAttribute("Locales with a namespace. Must contain `locale` if `namespacing` is enabled", types.ListType{ElemType: types.StringType}),
+			"installed_locales": {
+				MarkdownDescription: "Additional locales to install, whether or not `namespacing` is enabled. " +
+					"Wiki.js cannot uninstall locales, removing one from the set only stops managing it",
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"install_timeout": {
+				MarkdownDescription: fmt.Sprintf("Seconds to wait for a locale to be installed, defaults to %d", defaultLocaleInstallTimeout),
+				Type:                types.Int64Type,
+				Optional:            true,
+				Validators:          []tfsdk.AttributeValidator{int64AtLeast{min: 1}},
+			},
+		},
+	}, nil
+}
+
+func (t localeResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return localeResource{
+		provider: provider,
+	}, diags
+}
+
+type localeResourceData struct {
+	Id               types.String `tfsdk:"id"`
+	Locale           types.String `tfsdk:"locale"`
+	AutoUpdate       types.Bool   `tfsdk:"auto_update"`
+	Namespacing      types.Bool   `tfsdk:"namespacing"`
+	Namespaces       types.List   `tfsdk:"namespaces"`
+	InstalledLocales types.Set    `tfsdk:"installed_locales"`
+	InstallTimeout   types.Int64  `tfsdk:"install_timeout"`
+}
+
+func newLocaleResourceData(config *wikijs.LocalizationConfig, prior localeResourceData) localeResourceData {
+	return localeResourceData{
+		Id:               types.String{Value: localeId},
+		Locale:           types.String{Value: config.Locale},
+		AutoUpdate:       types.Bool{Value: config.AutoUpdate},
+		Namespacing:      types.Bool{Value: config.Namespacing},
+		Namespaces:       stringList(config.Namespaces),
+		InstalledLocales: prior.InstalledLocales,
+		InstallTimeout:   prior.InstallTimeout,
+	}
+}
+
+// installedLocales returns the locales of prior which are still installed,
+// so that locales removed outside of Terraform are installed again.
+func installedLocales(prior types.Set, locales []wikijs.Locale) types.Set {
+	if prior.Null || prior.Unknown {
+		return prior
+	}
+	installed := map[string]bool{}
+	for _, locale := range locales {
+		installed[locale.Code] = locale.IsInstalled
+	}
+	set := types.Set{
+		ElemType: types.StringType,
+		Elems:    []attr.Value{},
+	}
+	for _, element := range prior.Elems {
+		if code, ok := element.(types.String); ok && installed[code.Value] {
+			set.Elems = append(set.Elems, code)
+		}
+	}
+	return set
+}
+
+type localeResource struct {
+	provider provider
+}
+
+func (r localeResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data localeResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Locale.Unknown || data.Namespacing.Null || data.Namespacing.Unknown || !data.Namespacing.Value {
+		return
+	}
+	namespaces, diags := stringListPointer(ctx, data.Namespaces)
+	resp.Diagnostics.Append(diags...)
+	if namespaces == nil {
+		return
+	}
+	for _, namespace := range *namespaces {
+		if namespace == data.Locale.Value {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("namespaces"), "Missing Namespace",
+		fmt.Sprintf("With namespacing enabled, the namespaces must contain the site locale %s.", data.Locale.Value))
+}
+
+func (r localeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data localeResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r localeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data localeResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.provider.client.GetLocalizationConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read localization config, got error: %s", err))
+		return
+	}
+
+	data = newLocaleResourceData(config, data)
+	if !data.InstalledLocales.Null {
+		locales, err := r.provider.client.GetLocales()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read locales, got error: %s", err))
+			return
+		}
+		data.InstalledLocales = installedLocales(data.InstalledLocales, locales)
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r localeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data localeResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r localeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Wiki.js cannot uninstall locales, so the settings are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r localeResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply installs the missing locales, updates the configured settings and
+// stores all settings in state.
+func (r localeResource) apply(ctx context.Context, data localeResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config, err := r.provider.client.GetLocalizationConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read localization config, got error: %s", err))
+		return diags
+	}
+
+	config.Locale = data.Locale.Value
+	if value := boolPointer(data.AutoUpdate); value != nil {
+		config.AutoUpdate = *value
+	}
+	if value := boolPointer(data.Namespacing); value != nil {
+		config.Namespacing = *value
+	}
+	namespaces, moreDiags := stringListPointer(ctx, data.Namespaces)
+	diags.Append(moreDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if namespaces != nil {
+		config.Namespaces = *namespaces
+	}
+	if config.Namespaces == nil {
+		config.Namespaces = []string{}
+	}
+
+	timeout := time.Duration(defaultLocaleInstallTimeout) * time.Second
+	if !data.InstallTimeout.Null && !data.InstallTimeout.Unknown {
+		timeout = time.Duration(data.InstallTimeout.Value) * time.Second
+	}
+	codes := append([]string{config.Locale}, config.Namespaces...)
+	if !data.InstalledLocales.Null && !data.InstalledLocales.Unknown {
+		additional := []string{}
+		diags.Append(data.InstalledLocales.ElementsAs(ctx, &additional, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		codes = append(codes, additional...)
+	}
+	diags.Append(r.install(codes, timeout)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	err = r.provider.client.UpdateLocale(*config)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update localization config, got error: %s", err))
+		return diags
+	}
+
+	config, err = r.provider.client.GetLocalizationConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read localization config, got error: %s", err))
+		return diags
+	}
+
+	data = newLocaleResourceData(config, data)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
+
+// install downloads the locales which are not installed and waits until
+// they are.
+func (r localeResource) install(codes []string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	locales, err := r.provider.client.GetLocales()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read locales, got error: %s", err))
+		return diags
+	}
+	installed := map[string]bool{}
+	for _, locale := range locales {
+		installed[locale.Code] = locale.IsInstalled
+	}
+
+	for _, code := range codes {
+		isInstalled, available := installed[code]
+		if !available {
+			diags.AddError("Unknown Locale", fmt.Sprintf("Locale %s is not available for download.", code))
+			continue
+		}
+		if isInstalled {
+			continue
+		}
+		err = r.provider.client.DownloadLocale(code)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to download locale %s, got error: %s", code, err))
+			continue
+		}
+		err = r.provider.client.WaitForLocale(code, timeout)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to install locale %s, got error: %s", code, err))
+			continue
+		}
+		installed[code] = true
+	}
+	return diags
+}
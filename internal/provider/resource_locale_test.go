@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLocaleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLocaleResourceConfig(`["en", "fr"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_locale.test", "id", "locale"),
+					resource.TestCheckResourceAttr("wikijs_locale.test", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("data.wikijs_locales.installed", "locales.1.code", "fr"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccLocaleResourceConfig(`["en"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_locale.test", "namespaces.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLocaleResourceConfig(namespaces string) string {
+	return `
+resource "wikijs_locale" "test" {
+	locale      = "en"
+	namespacing = true
+	namespaces  = ` + namespaces + `
+}
+
+data "wikijs_locales" "installed" {
+	installed = true
+
+	depends_on = [wikijs_locale.test]
+}
+`
+}
+
+func TestLocaleResource(t *testing.T) {
+	if testServer == nil {
+		t.Skip("installing a locale needs the fake Wiki.js server")
+	}
+	testServer.DelayLocaleInstall(2)
+	defer testServer.DelayLocaleInstall(0)
+	pollInterval := wikijs.LocalePollInterval
+	wikijs.LocalePollInterval = 10 * time.Millisecond
+	defer func() { wikijs.LocalePollInterval = pollInterval }()
+
+	original, err := wikijsClient.GetLocalizationConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := wikijsClient.UpdateLocale(*original); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	h := newResourceHarness(t, "wikijs_locale")
+	config := map[string]interface{}{
+		"locale":      "de",
+		"namespacing": true,
+		"namespaces":  []string{"de", "es"},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	localizationConfig, err := wikijsClient.GetLocalizationConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if localizationConfig.Locale != "de" || !reflect.DeepEqual(localizationConfig.Namespaces, []string{"de", "es"}) ||
+		localizationConfig.AutoUpdate != original.AutoUpdate {
+		t.Errorf("unexpected localization config after create: %+v", localizationConfig)
+	}
+
+	locales := newDataSourceHarness(t, "wikijs_locales")
+	if diags := locales.readDataSource(map[string]interface{}{"installed": true}); hasError(diags) {
+		t.Fatalf("read locales: %v", diags)
+	}
+	codes := []interface{}{}
+	for _, locale := range locales.attributes()["locales"].([]interface{}) {
+		codes = append(codes, locale.(map[string]interface{})["code"])
+	}
+	if !reflect.DeepEqual(codes, []interface{}{"de", "en", "es"}) {
+		t.Errorf("installed locales = %v, want [de en es]", codes)
+	}
+
+	config["auto_update"] = false
+	config["namespaces"] = []string{"de"}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if h.attributes()["auto_update"] != false {
+		t.Errorf("auto_update = %v, want false", h.attributes()["auto_update"])
+	}
+
+	// Locales are installed without a namespace.
+	config = map[string]interface{}{
+		"locale":            "de",
+		"namespacing":       false,
+		"installed_locales": []string{"ja"},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("install without namespace: %v", diags)
+	}
+	available, err := wikijsClient.GetLocales()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, locale := range available {
+		if locale.Code == "ja" && !locale.IsInstalled {
+			t.Errorf("locale ja is not installed")
+		}
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if !reflect.DeepEqual(h.attributes()["installed_locales"], []interface{}{"ja"}) {
+		t.Errorf("installed_locales = %v, want [ja]", h.attributes()["installed_locales"])
+	}
+
+	invalid := []map[string]interface{}{
+		{"locale": "de", "namespacing": true, "namespaces": []string{"es"}},
+		{"locale": "xx"},
+		{"locale": "de", "installed_locales": []string{"xx"}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+}
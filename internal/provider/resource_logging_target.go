@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type loggingTargetResourceType struct{}
+
+func (t loggingTargetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	level := settingAttribute("Minimum level of the messages sent to the logging target, one of `"+strings.Join(wikijs.LogLevels, "`, `")+"`", types.StringType)
+	level.Validators = []tfsdk.AttributeValidator{stringOneOf{values: wikijs.LogLevels}}
+
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"key": {
+			MarkdownDescription: "Key of the logging target, e.g. `sentry`, `loggly`, `papertrail` or `syslog`",
+			Type:                types.StringType,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"title": {
+			MarkdownDescription: "Title of the logging target",
+			Type:                types.StringType,
+			Computed:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"is_enabled": {
+			MarkdownDescription: "Whether the logging target is enabled",
+			Type:                types.BoolType,
+			Required:            true,
+		},
+		"level": level,
+	}
+	for name, attribute := range moduleConfigAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Logging target which receives the log messages of Wiki.js from the configured level on, e.g. Sentry or a syslog server. " +
+			"Destroying the resource disables the logging target.",
+		Attributes: attributes,
+	}, nil
+}
+
+func (t loggingTargetResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return loggingTargetResource{
+		provider: provider,
+	}, diags
+}
+
+type loggingTargetResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	Level           types.String `tfsdk:"level"`
+	Config          types.Map    `tfsdk:"config"`
+	SensitiveConfig types.Map    `tfsdk:"sensitive_config"`
+}
+
+func newLoggingTargetResourceData(target module, prior loggingTargetResourceData) (loggingTargetResourceData, error) {
+	properties, err := wikijs.DecodeModuleConfig(target.Config)
+	if err != nil {
+		return prior, err
+	}
+
+	return loggingTargetResourceData{
+		Id:              types.String{Value: target.Key},
+		Key:             types.String{Value: target.Key},
+		Title:           types.String{Value: target.Title},
+		IsEnabled:       types.Bool{Value: target.IsEnabled},
+		Level:           types.String{Value: target.Level},
+		Config:          newModuleConfig(properties, prior.Config),
+		SensitiveConfig: prior.SensitiveConfig,
+	}, nil
+}
+
+// loggingTargetModules returns the logging targets as module list.
+func loggingTargetModules(client *wikijs.WikijsClient) moduleList {
+	return moduleList{
+		name: "logging target",
+		get: func() ([]module, error) {
+			targets, err := client.GetLoggingTargets()
+			if err != nil {
+				return nil, err
+			}
+			modules := []module{}
+			for _, target := range targets {
+				modules = append(modules, module{Key: target.Key, Title: target.Title, IsEnabled: target.IsEnabled, Level: target.Level, Config: target.Config})
+			}
+			return modules, nil
+		},
+		update: func(modules []module) error {
+			inputs := []wikijs.LoggingTargetInput{}
+			for _, module := range modules {
+				inputs = append(inputs, wikijs.LoggingTargetInput{IsEnabled: module.IsEnabled, Key: module.Key, Level: module.Level, Config: module.Config})
+			}
+			return client.UpdateLoggingTargets(inputs)
+		},
+	}
+}
+
+type loggingTargetResource struct {
+	provider provider
+}
+
+func (r loggingTargetResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data loggingTargetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r loggingTargetResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data loggingTargetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.Value
+	if data.Key.Null {
+		// imported by key
+		key = data.Id.Value
+	}
+
+	target, diags := loggingTargetModules(r.provider.client).read(key)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := newLoggingTargetResourceData(*target, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read logging target, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r loggingTargetResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data loggingTargetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r loggingTargetResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data loggingTargetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Logging targets cannot be deleted, so the target is disabled.
+	resp.Diagnostics.Append(loggingTargetModules(r.provider.client).disable(data.Key.Value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r loggingTargetResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply updates the logging target. Without a configured level the current
+// one is kept.
+func (r loggingTargetResource) apply(ctx context.Context, data loggingTargetResourceData, state *tfsdk.State) diag.Diagnostics {
+	target, diags := loggingTargetModules(r.provider.client).applyLevel(ctx, data.Key.Value, data.IsEnabled.Value, stringPointer(data.Level), data.Config, data.SensitiveConfig)
+	if diags.HasError() {
+		return diags
+	}
+
+	data, err := newLoggingTargetResourceData(*target, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read logging target, got error: %s", err))
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
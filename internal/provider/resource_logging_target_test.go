@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLoggingTargetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLoggingTargetResourceConfig("error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_logging_target.test", "id", "syslog"),
+					resource.TestCheckResourceAttr("wikijs_logging_target.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("wikijs_logging_target.test", "level", "error"),
+					resource.TestCheckResourceAttr("wikijs_logging_target.test", "config.host", "logs.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_logging_target.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccLoggingTargetResourceConfig("info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_logging_target.test", "level", "info"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLoggingTargetResourceConfig(level string) string {
+	return `
+resource "wikijs_logging_target" "test" {
+	key        = "syslog"
+	is_enabled = true
+	level      = "` + level + `"
+	config = {
+		host = "logs.example.com"
+	}
+}
+`
+}
+
+func TestLoggingTargetResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_logging_target")
+
+	config := map[string]interface{}{
+		"key":        "papertrail",
+		"is_enabled": true,
+		"level":      "error",
+		"config": map[string]string{
+			"host": "logs.papertrailapp.com",
+			"port": "12345",
+		},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if h.attributes()["title"] != "Papertrail" {
+		t.Errorf("title = %v, want Papertrail", h.attributes()["title"])
+	}
+	target := loggingTarget(t, "papertrail")
+	if !target.IsEnabled || target.Level != "error" {
+		t.Errorf("unexpected logging target %v", target)
+	}
+	values := loggingTargetConfig(t, target)
+	if values["host"] != "logs.papertrailapp.com" || values["port"] != 12345.0 {
+		t.Errorf("unexpected config %v", values)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Without a level the current one is kept.
+	other := newResourceHarness(t, "wikijs_logging_target")
+	if diags := other.apply(map[string]interface{}{"key": "disk", "is_enabled": true}); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if level := other.attributes()["level"]; level != "warn" {
+		t.Errorf("level = %v, want warn", level)
+	}
+	if diags := other.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+
+	// Level changed in Wiki.js is detected.
+	targets, err := wikijsClient.GetLoggingTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs, err := wikijs.LoggingTargetInputs(targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range inputs {
+		if inputs[i].Key == "papertrail" {
+			inputs[i].Level = "debug"
+		}
+	}
+	if err := wikijsClient.UpdateLoggingTargets(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if level := h.attributes()["level"]; level != "debug" {
+		t.Errorf("drift not detected, level is %v", level)
+	}
+
+	invalid := []map[string]interface{}{
+		{"key": "papertrail", "is_enabled": true, "level": "critical"},
+		{"key": "papertrail", "is_enabled": true, "config": map[string]string{"port": "high"}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+	if diags := newResourceHarness(t, "wikijs_logging_target").apply(map[string]interface{}{"key": "console", "is_enabled": true}); !hasError(diags) {
+		t.Errorf("expected unknown logging target to be invalid")
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	targets, err = wikijsClient.GetLoggingTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, target := range targets {
+		if target.IsEnabled {
+			t.Errorf("expected %s to be disabled after destroy", target.Key)
+		}
+	}
+}
+
+func loggingTarget(t *testing.T, key string) wikijs.LoggingTarget {
+	targets, err := wikijsClient.GetLoggingTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, target := range targets {
+		if target.Key == key {
+			return target
+		}
+	}
+	t.Fatalf("logging target %s does not exist", key)
+	return wikijs.LoggingTarget{}
+}
+
+func loggingTargetConfig(t *testing.T, target wikijs.LoggingTarget) map[string]interface{} {
+	properties, err := wikijs.DecodeModuleConfig(target.Config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return wikijs.ModuleConfigValues(properties)
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const mailConfigId = "mail_config"
+
+type mailConfigResourceType struct{}
+
+func (t mailConfigResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	port := settingAttribute("Port of the SMTP server", types.Int64Type)
+	port.Validators = []tfsdk.AttributeValidator{int64AtLeast{min: 1}}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SMTP settings used for mails like password resets. Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"sender_name":  settingAttribute("Name mails are sent from", types.StringType),
+			"sender_email": settingAttribute("Address mails are sent from", types.StringType),
+			"host":         settingAttribute("Host of the SMTP server", types.StringType),
+			"port":         port,
+			"name":         settingAttribute("Client hostname announced to the SMTP server", types.StringType),
+			"secure":       settingAttribute("Use TLS to connect to the SMTP server", types.BoolType),
+			"verify_ssl":   settingAttribute("Verify the certificate of the SMTP server", types.BoolType),
+			"user":         settingAttribute("Username to authenticate with", types.StringType),
+			"password": {
+				MarkdownDescription: "Password to authenticate with. Wiki.js does not return it, so changes made outside of Terraform are not detected",
+				Type:                types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"use_dkim":          settingAttribute("Sign mails with DKIM", types.BoolType),
+			"dkim_domain_name":  settingAttribute("Domain name of the DKIM signature", types.StringType),
+			"dkim_key_selector": settingAttribute("Key selector of the DKIM signature", types.StringType),
+			"dkim_private_key": {
+				MarkdownDescription: "Private key of the DKIM signature in PEM format",
+				Type:                types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"test_recipient": {
+				MarkdownDescription: "Send a test mail to this address whenever the settings are applied. The apply fails if the mail cannot be sent, e.g. due to bad credentials, " +
+					"and the previous settings are restored. A changed password can only be restored if it was managed by Terraform before",
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}, nil
+}
+
+func (t mailConfigResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return mailConfigResource{
+		provider: provider,
+	}, diags
+}
+
+type mailConfigResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	SenderName      types.String `tfsdk:"sender_name"`
+	SenderEmail     types.String `tfsdk:"sender_email"`
+	Host            types.String `tfsdk:"host"`
+	Port            types.Int64  `tfsdk:"port"`
+	Name            types.String `tfsdk:"name"`
+	Secure          types.Bool   `tfsdk:"secure"`
+	VerifySSL       types.Bool   `tfsdk:"verify_ssl"`
+	User            types.String `tfsdk:"user"`
+	Password        types.String `tfsdk:"password"`
+	UseDKIM         types.Bool   `tfsdk:"use_dkim"`
+	DKIMDomainName  types.String `tfsdk:"dkim_domain_name"`
+	DKIMKeySelector types.String `tfsdk:"dkim_key_selector"`
+	DKIMPrivateKey  types.String `tfsdk:"dkim_private_key"`
+	TestRecipient   types.String `tfsdk:"test_recipient"`
+}
+
+// mailConfig merges the configured settings into config. An unset password
+// keeps the stored one.
+func (data mailConfigResourceData) mailConfig(config wikijs.MailConfig) wikijs.MailConfig {
+	if value := stringPointer(data.SenderName); value != nil {
+		config.SenderName = *value
+	}
+	if value := stringPointer(data.SenderEmail); value != nil {
+		config.SenderEmail = *value
+	}
+	if value := stringPointer(data.Host); value != nil {
+		config.Host = *value
+	}
+	if value := int64Pointer(data.Port); value != nil {
+		config.Port = *value
+	}
+	if value := stringPointer(data.Name); value != nil {
+		config.Name = *value
+	}
+	if value := boolPointer(data.Secure); value != nil {
+		config.Secure = *value
+	}
+	if value := boolPointer(data.VerifySSL); value != nil {
+		config.VerifySSL = *value
+	}
+	if value := stringPointer(data.User); value != nil {
+		config.User = *value
+	}
+	config.Pass = wikijs.MaskedMailPassword
+	if value := stringPointer(data.Password); value != nil {
+		config.Pass = *value
+	}
+	if value := boolPointer(data.UseDKIM); value != nil {
+		config.UseDKIM = *value
+	}
+	if value := stringPointer(data.DKIMDomainName); value != nil {
+		config.DKIMDomainName = *value
+	}
+	if value := stringPointer(data.DKIMKeySelector); value != nil {
+		config.DKIMKeySelector = *value
+	}
+	if value := stringPointer(data.DKIMPrivateKey); value != nil {
+		config.DKIMPrivateKey = *value
+	}
+	return config
+}
+
+// newMailConfigResourceData returns the settings of config. The secrets and
+// the test recipient are kept from prior.
+func newMailConfigResourceData(config *wikijs.MailConfig, prior mailConfigResourceData) mailConfigResourceData {
+	return mailConfigResourceData{
+		Id:              types.String{Value: mailConfigId},
+		SenderName:      types.String{Value: config.SenderName},
+		SenderEmail:     types.String{Value: config.SenderEmail},
+		Host:            types.String{Value: config.Host},
+		Port:            types.Int64{Value: config.Port},
+		Name:            types.String{Value: config.Name},
+		Secure:          types.Bool{Value: config.Secure},
+		VerifySSL:       types.Bool{Value: config.VerifySSL},
+		User:            types.String{Value: config.User},
+		Password:        prior.Password,
+		UseDKIM:         types.Bool{Value: config.UseDKIM},
+		DKIMDomainName:  types.String{Value: config.DKIMDomainName},
+		DKIMKeySelector: types.String{Value: config.DKIMKeySelector},
+		DKIMPrivateKey:  prior.DKIMPrivateKey,
+		TestRecipient:   prior.TestRecipient,
+	}
+}
+
+type mailConfigResource struct {
+	provider provider
+}
+
+func (r mailConfigResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data mailConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, nil, &resp.State)...)
+}
+
+func (r mailConfigResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data mailConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mailConfig, err := r.provider.client.GetMailConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail config, got error: %s", err))
+		return
+	}
+
+	data = newMailConfigResourceData(mailConfig, data)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r mailConfigResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data, prior mailConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &prior)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &prior, &resp.State)...)
+}
+
+func (r mailConfigResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The mail config cannot be deleted, so the settings are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r mailConfigResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply merges the planned settings into the current ones, sets them and
+// sends a test mail if a test recipient is configured. If the test mail
+// fails, the current settings are restored and the state is not updated, so
+// the test is retried on the next apply. Wiki.js only returns the password
+// masked, so a changed password is restored from prior, the state before the
+// update, if it holds one.
+func (r mailConfigResource) apply(ctx context.Context, data mailConfigResourceData, prior *mailConfigResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	mailConfig, err := r.provider.client.GetMailConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read mail config, got error: %s", err))
+		return diags
+	}
+
+	err = r.provider.client.UpdateMailConfig(data.mailConfig(*mailConfig))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update mail config, got error: %s", err))
+		return diags
+	}
+
+	if recipient := stringPointer(data.TestRecipient); recipient != nil {
+		err = r.provider.client.SendTestMail(*recipient)
+		if err != nil {
+			restored := "The previous settings were restored."
+			previous := *mailConfig
+			if prior != nil && stringPointer(prior.Password) != nil {
+				previous.Pass = prior.Password.Value
+			} else if stringPointer(data.Password) != nil && previous.Pass == wikijs.MaskedMailPassword {
+				restored = "The previous settings were restored, except for the password, which was not managed by Terraform before."
+			}
+			if restoreErr := r.provider.client.UpdateMailConfig(previous); restoreErr != nil {
+				restored = fmt.Sprintf("The settings remain applied, as the previous ones could not be restored, got error: %s", restoreErr)
+			}
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("test_recipient"), "Test Mail Failed",
+				fmt.Sprintf("Unable to send a test mail to %s with the applied settings, got error: %s. %s", *recipient, err, restored))
+			return diags
+		}
+	}
+
+	mailConfig, err = r.provider.client.GetMailConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read mail config, got error: %s", err))
+		return diags
+	}
+
+	data = newMailConfigResourceData(mailConfig, data)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
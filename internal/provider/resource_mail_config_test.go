@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMailConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccMailConfigResourceConfig("smtp.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_mail_config.test", "id", "mail_config"),
+					resource.TestCheckResourceAttr("wikijs_mail_config.test", "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr("wikijs_mail_config.test", "port", "587"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_mail_config.test",
+				ImportState:             true,
+				ImportStateId:           "mail_config",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccMailConfigResourceConfig("mail.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_mail_config.test", "host", "mail.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMailConfigResourceConfig(host string) string {
+	return `
+resource "wikijs_mail_config" "test" {
+	sender_name  = "Wiki"
+	sender_email = "wiki@example.com"
+	host         = "` + host + `"
+	port         = 587
+	secure       = false
+	user         = "wiki"
+	password     = "s3cret"
+}
+`
+}
+
+func TestMailConfigResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_mail_config")
+
+	original, err := wikijsClient.GetMailConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := wikijsClient.UpdateMailConfig(*original); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	config := map[string]interface{}{
+		"sender_name":  "Wiki",
+		"sender_email": "wiki@example.com",
+		"host":         "smtp.example.com",
+		"port":         587,
+		"secure":       false,
+		"user":         "wiki",
+		"password":     "s3cret",
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	attributes := h.attributes()
+	if attributes["host"] != "smtp.example.com" || attributes["password"] != "s3cret" {
+		t.Errorf("unexpected state %v", attributes)
+	}
+	mailConfig, err := wikijsClient.GetMailConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mailConfig.Pass != wikijs.MaskedMailPassword {
+		t.Errorf("expected the password to be set, got %q", mailConfig.Pass)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Changes made in Wiki.js are detected, the masked password is kept.
+	mailConfig.Host = "relay.example.com"
+	if err := wikijsClient.UpdateMailConfig(*mailConfig); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if host := h.attributes()["host"]; host != "relay.example.com" {
+		t.Errorf("drift not detected, host is %v", host)
+	}
+	if password := h.attributes()["password"]; password != "s3cret" {
+		t.Errorf("password = %v, want s3cret", password)
+	}
+
+	if diags := h.apply(map[string]interface{}{"port": 0}); !hasError(diags) {
+		t.Errorf("expected port 0 to be invalid")
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+}
+
+func TestMailConfigResourceTestRecipient(t *testing.T) {
+	if testServer == nil {
+		t.Skip("sending mails needs the fake Wiki.js server to reach the local SMTP server")
+	}
+	smtpServer := wikijstest.NewSMTPServer("wiki", "s3cret")
+	defer smtpServer.Close()
+
+	original, err := wikijsClient.GetMailConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := wikijsClient.UpdateMailConfig(*original); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	h := newResourceHarness(t, "wikijs_mail_config")
+	config := map[string]interface{}{
+		"sender_email":   "wiki@example.com",
+		"host":           smtpServer.Host,
+		"port":           smtpServer.Port,
+		"secure":         false,
+		"user":           "wiki",
+		"password":       "s3cret",
+		"test_recipient": "admin@example.com",
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	messages := smtpServer.Messages()
+	if len(messages) != 1 || !reflect.DeepEqual(messages[0].To, []string{"admin@example.com"}) {
+		t.Errorf("expected a test mail to admin@example.com, got %v", messages)
+	}
+
+	// A bad credential fails the apply and keeps the prior state.
+	config["password"] = "wrong"
+	if diags := h.apply(config); !hasError(diags) {
+		t.Errorf("expected the test mail to fail")
+	}
+	if password := h.attributes()["password"]; password != "s3cret" {
+		t.Errorf("password = %v, want the prior s3cret", password)
+	}
+	if len(smtpServer.Messages()) != 1 {
+		t.Errorf("expected no further mail, got %v", smtpServer.Messages())
+	}
+	// The previous password is restored, so mail can still be sent.
+	if err := wikijsClient.SendTestMail("admin@example.com"); err != nil {
+		t.Errorf("expected the previous settings to be restored, got %s", err)
+	}
+
+	config["password"] = "s3cret"
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if len(smtpServer.Messages()) != 3 {
+		t.Errorf("expected another test mail, got %v", smtpServer.Messages())
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+}
@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type navigationResourceType struct{}
+
+func (t navigationResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	mode := settingAttribute("Navigation mode of the site, one of `NONE`, `TREE` (site tree only), `MIXED` (navigation above the site tree) or `STATIC` (navigation only)", types.StringType)
+	mode.Validators = []tfsdk.AttributeValidator{stringOneOf{values: []string{"NONE", "TREE", "MIXED", "STATIC"}}}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Navigation items of the sidebar for a locale. The navigation mode applies to all locales, so only one resource should configure it.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"locale": {
+				MarkdownDescription: "Locale of the navigation, e.g. `en`",
+				Type:                types.StringType,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"mode": mode,
+			"items": {
+				MarkdownDescription: "Navigation items in order",
+				Required:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"kind": {
+						MarkdownDescription: "Kind of the item, one of `link`, `header` or `divider`",
+						Type:                types.StringType,
+						Required:            true,
+						Validators:          []tfsdk.AttributeValidator{stringOneOf{values: []string{"link", "header", "divider"}}},
+					},
+					"label": {
+						MarkdownDescription: "Label of a link or header",
+						Type:                types.StringType,
+						Optional:            true,
+					},
+					"icon": {
+						MarkdownDescription: "Icon of a link, e.g. `mdi-home`",
+						Type:                types.StringType,
+						Optional:            true,
+					},
+					"target_type": {
+						MarkdownDescription: "Target type of a link, one of `home`, `page`, `search` or `url`",
+						Type:                types.StringType,
+						Optional:            true,
+						Validators:          []tfsdk.AttributeValidator{stringOneOf{values: []string{"home", "page", "search", "url"}}},
+					},
+					"target": {
+						MarkdownDescription: "Target of a link: the page path, search query or URL",
+						Type:                types.StringType,
+						Optional:            true,
+					},
+					"visibility_mode": {
+						MarkdownDescription: "Who sees the item, `all` (default) or `restricted` to `visibility_groups`",
+						Type:                types.StringType,
+						Optional:            true,
+						Validators:          []tfsdk.AttributeValidator{stringOneOf{values: []string{"all", "restricted"}}},
+					},
+					"visibility_groups": {
+						MarkdownDescription: "IDs of the groups which see a restricted item",
+						Type:                types.ListType{ElemType: types.Int64Type},
+						Optional:            true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t navigationResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return navigationResource{
+		provider: provider,
+	}, diags
+}
+
+type navigationResourceData struct {
+	Id     types.String             `tfsdk:"id"`
+	Locale types.String             `tfsdk:"locale"`
+	Mode   types.String             `tfsdk:"mode"`
+	Items  []navigationItemResource `tfsdk:"items"`
+}
+
+type navigationItemResource struct {
+	Kind             types.String `tfsdk:"kind"`
+	Label            types.String `tfsdk:"label"`
+	Icon             types.String `tfsdk:"icon"`
+	TargetType       types.String `tfsdk:"target_type"`
+	Target           types.String `tfsdk:"target"`
+	VisibilityMode   types.String `tfsdk:"visibility_mode"`
+	VisibilityGroups types.List   `tfsdk:"visibility_groups"`
+}
+
+// navigationItem returns the item as sent to Wiki.js, with id as its id.
+func (item navigationItemResource) navigationItem(ctx context.Context, id string) (wikijs.NavigationItem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	navigationItem := wikijs.NavigationItem{
+		Id:             id,
+		Kind:           item.Kind.Value,
+		Label:          item.Label.Value,
+		Icon:           item.Icon.Value,
+		TargetType:     item.TargetType.Value,
+		Target:         item.Target.Value,
+		VisibilityMode: item.VisibilityMode.Value,
+	}
+	if navigationItem.VisibilityMode == "" {
+		navigationItem.VisibilityMode = "all"
+	}
+	if !item.VisibilityGroups.Null && !item.VisibilityGroups.Unknown {
+		diags = item.VisibilityGroups.ElementsAs(ctx, &navigationItem.VisibilityGroups, false)
+	}
+	return navigationItem, diags
+}
+
+// equivalent reports whether item and navigationItem only differ in
+// defaults, e.g. an unset visibility mode and `all`.
+func (item navigationItemResource) equivalent(ctx context.Context, navigationItem wikijs.NavigationItem) bool {
+	expected, diags := item.navigationItem(ctx, navigationItem.Id)
+	if diags.HasError() {
+		return false
+	}
+	if len(expected.VisibilityGroups) == 0 && len(navigationItem.VisibilityGroups) == 0 {
+		expected.VisibilityGroups = navigationItem.VisibilityGroups
+	}
+	return reflect.DeepEqual(expected, navigationItem)
+}
+
+func newNavigationItemResource(navigationItem wikijs.NavigationItem) navigationItemResource {
+	optional := func(value string) types.String {
+		if value == "" {
+			return types.String{Null: true}
+		}
+		return types.String{Value: value}
+	}
+
+	item := navigationItemResource{
+		Kind:             types.String{Value: navigationItem.Kind},
+		Label:            optional(navigationItem.Label),
+		Icon:             optional(navigationItem.Icon),
+		TargetType:       optional(navigationItem.TargetType),
+		Target:           optional(navigationItem.Target),
+		VisibilityMode:   optional(navigationItem.VisibilityMode),
+		VisibilityGroups: types.List{ElemType: types.Int64Type, Null: true},
+	}
+	if navigationItem.VisibilityMode == "all" {
+		item.VisibilityMode = types.String{Null: true}
+	}
+	if len(navigationItem.VisibilityGroups) > 0 {
+		item.VisibilityGroups.Null = false
+		for _, group := range navigationItem.VisibilityGroups {
+			item.VisibilityGroups.Elems = append(item.VisibilityGroups.Elems, types.Int64{Value: group})
+		}
+	}
+	return item
+}
+
+// newNavigationResourceData returns the navigation of locale. Items of prior
+// equivalent to the items in Wiki.js are kept as they are.
+func newNavigationResourceData(ctx context.Context, locale string, tree []wikijs.NavigationTree, config *wikijs.NavigationConfig, prior navigationResourceData) navigationResourceData {
+	data := navigationResourceData{
+		Id:     types.String{Value: locale},
+		Locale: types.String{Value: locale},
+		Mode:   types.String{Null: true},
+		Items:  []navigationItemResource{},
+	}
+	if config != nil {
+		data.Mode = types.String{Value: config.Mode}
+	}
+	for _, navigationTree := range tree {
+		if navigationTree.Locale != locale {
+			continue
+		}
+		for i, navigationItem := range navigationTree.Items {
+			if i < len(prior.Items) && prior.Items[i].equivalent(ctx, navigationItem) {
+				data.Items = append(data.Items, prior.Items[i])
+				continue
+			}
+			data.Items = append(data.Items, newNavigationItemResource(navigationItem))
+		}
+	}
+	return data
+}
+
+// navigationItemId returns a random item id like the ones of the admin UI.
+func navigationItemId() (string, error) {
+	id := make([]byte, 5)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+type navigationResource struct {
+	provider provider
+}
+
+func (r navigationResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data navigationResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, item := range data.Items {
+		path := tftypes.NewAttributePath().WithAttributeName("items").WithElementKeyInt(i)
+		if item.Kind.Unknown {
+			continue
+		}
+		switch item.Kind.Value {
+		case "link":
+			if item.Label.Null {
+				resp.Diagnostics.AddAttributeError(path.WithAttributeName("label"), "Missing Attribute", "Links require a label.")
+			}
+			if item.TargetType.Null {
+				resp.Diagnostics.AddAttributeError(path.WithAttributeName("target_type"), "Missing Attribute", "Links require a target type.")
+			} else if item.TargetType.Value != "home" && item.Target.Null {
+				resp.Diagnostics.AddAttributeError(path.WithAttributeName("target"), "Missing Attribute",
+					fmt.Sprintf("Links with target type %s require a target.", item.TargetType.Value))
+			}
+		case "header":
+			if item.Label.Null {
+				resp.Diagnostics.AddAttributeError(path.WithAttributeName("label"), "Missing Attribute", "Headers require a label.")
+			}
+		}
+		if item.VisibilityMode.Value == "restricted" && item.VisibilityGroups.Null {
+			resp.Diagnostics.AddAttributeError(path.WithAttributeName("visibility_groups"), "Missing Attribute",
+				"Restricted items require visibility groups.")
+		}
+	}
+}
+
+func (r navigationResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data navigationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r navigationResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data navigationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locale := data.Locale.Value
+	if data.Locale.Null {
+		// imported by locale
+		locale = data.Id.Value
+	}
+
+	data, diags = r.read(ctx, locale, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r navigationResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data navigationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r navigationResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data navigationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tree, err := r.provider.client.GetNavigationTree()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read navigation, got error: %s", err))
+		return
+	}
+
+	// The navigation mode applies to all locales, so it is left as it is.
+	remaining := []wikijs.NavigationTree{}
+	for _, navigationTree := range tree {
+		if navigationTree.Locale != data.Locale.Value {
+			remaining = append(remaining, navigationTree)
+		}
+	}
+
+	err = r.provider.client.UpdateNavigationTree(remaining)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete navigation, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r navigationResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply replaces the items of the locale, keeping the navigation of other
+// locales, and sets the mode if it is configured.
+func (r navigationResource) apply(ctx context.Context, data navigationResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tree, err := r.provider.client.GetNavigationTree()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read navigation, got error: %s", err))
+		return diags
+	}
+
+	// Keep the ids of existing items, so that only changed items change.
+	existing := []wikijs.NavigationItem{}
+	updated := []wikijs.NavigationTree{}
+	for _, navigationTree := range tree {
+		if navigationTree.Locale == data.Locale.Value {
+			existing = navigationTree.Items
+			continue
+		}
+		updated = append(updated, navigationTree)
+	}
+
+	navigationTree := wikijs.NavigationTree{Locale: data.Locale.Value, Items: []wikijs.NavigationItem{}}
+	for i, item := range data.Items {
+		var id string
+		if i < len(existing) {
+			id = existing[i].Id
+		} else if id, err = navigationItemId(); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to generate navigation item id, got error: %s", err))
+			return diags
+		}
+		navigationItem, moreDiags := item.navigationItem(ctx, id)
+		diags.Append(moreDiags...)
+		navigationTree.Items = append(navigationTree.Items, navigationItem)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	updated = append(updated, navigationTree)
+
+	err = r.provider.client.UpdateNavigationTree(updated)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update navigation, got error: %s", err))
+		return diags
+	}
+
+	if mode := stringPointer(data.Mode); mode != nil {
+		err = r.provider.client.UpdateNavigationConfig(*mode)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update navigation mode, got error: %s", err))
+			return diags
+		}
+	}
+
+	data, moreDiags := r.read(ctx, data.Locale.Value, data)
+	diags.Append(moreDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
+
+func (r navigationResource) read(ctx context.Context, locale string, prior navigationResourceData) (navigationResourceData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tree, err := r.provider.client.GetNavigationTree()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read navigation, got error: %s", err))
+		return prior, diags
+	}
+
+	// Without navigation modes the mode stays null.
+	config, err := r.provider.client.GetNavigationConfig()
+	if err != nil && !wikijs.IsUnsupportedField(err) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read navigation mode, got error: %s", err))
+		return prior, diags
+	}
+
+	return newNavigationResourceData(ctx, locale, tree, config, prior), diags
+}
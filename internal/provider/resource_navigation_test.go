@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNavigationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNavigationResourceConfig("Guide"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_navigation.test", "id", "fr"),
+					resource.TestCheckResourceAttr("wikijs_navigation.test", "items.#", "3"),
+					resource.TestCheckResourceAttr("wikijs_navigation.test", "items.1.label", "Guide"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "wikijs_navigation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccNavigationResourceConfig("Manual"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_navigation.test", "items.1.label", "Manual"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNavigationResourceConfig(label string) string {
+	return `
+resource "wikijs_navigation" "test" {
+	locale = "fr"
+	items = [
+		{
+			kind  = "header"
+			label = "Documentation"
+		},
+		{
+			kind        = "link"
+			label       = "` + label + `"
+			icon        = "mdi-book"
+			target_type = "page"
+			target      = "/fr/guide"
+		},
+		{
+			kind = "divider"
+		},
+	]
+}
+`
+}
+
+func TestNavigationResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_navigation")
+
+	config := map[string]interface{}{
+		"locale": "de",
+		"mode":   "MIXED",
+		"items": []map[string]interface{}{
+			{"kind": "header", "label": "Dokumentation"},
+			{"kind": "link", "label": "Anleitung", "icon": "mdi-book", "target_type": "page", "target": "/de/anleitung", "visibility_mode": "all"},
+			{"kind": "link", "label": "Intern", "target_type": "url", "target": "https://intranet.example.com", "visibility_mode": "restricted", "visibility_groups": []interface{}{2}},
+			{"kind": "divider"},
+		},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+
+	tree, err := wikijsClient.GetNavigationTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := navigationItems(tree, "de")
+	if len(items) != 4 || items[2].VisibilityMode != "restricted" || !reflect.DeepEqual(items[2].VisibilityGroups, []int64{2}) || items[3].VisibilityMode != "all" {
+		t.Errorf("unexpected navigation after create: %+v", items)
+	}
+	// The navigation of other locales is kept.
+	if len(navigationItems(tree, "en")) == 0 {
+		t.Errorf("navigation of en was removed: %+v", tree)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Items changed in Wiki.js are detected.
+	items[0].Label = "Docs"
+	if err := wikijsClient.UpdateNavigationTree(tree); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if label := h.attributes()["items"].([]interface{})[0].(map[string]interface{})["label"]; label != "Docs" {
+		t.Errorf("drift not detected, label is %v", label)
+	}
+
+	config["items"] = []map[string]interface{}{
+		{"kind": "link", "label": "Start", "target_type": "home"},
+	}
+	config["mode"] = "STATIC"
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	tree, err = wikijsClient.GetNavigationTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	items = navigationItems(tree, "de")
+	if len(items) != 1 || items[0].Label != "Start" {
+		t.Errorf("unexpected navigation after update: %+v", items)
+	}
+	navigationConfig, err := wikijsClient.GetNavigationConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if navigationConfig.Mode != "STATIC" {
+		t.Errorf("mode = %s, want STATIC", navigationConfig.Mode)
+	}
+
+	imported := newResourceHarness(t, "wikijs_navigation")
+	if diags := imported.importState("de"); hasError(diags) {
+		t.Fatalf("import: %v", diags)
+	}
+	if !reflect.DeepEqual(imported.attributes(), h.attributes()) {
+		t.Errorf("imported %v, want %v", imported.attributes(), h.attributes())
+	}
+
+	invalid := []map[string]interface{}{
+		{"locale": "de", "items": []map[string]interface{}{{"kind": "link", "label": "Missing target", "target_type": "page"}}},
+		{"locale": "de", "items": []map[string]interface{}{{"kind": "header"}}},
+		{"locale": "de", "items": []map[string]interface{}{{"kind": "link", "label": "Restricted", "target_type": "home", "visibility_mode": "restricted"}}},
+		{"locale": "de", "mode": "SIDEWAYS", "items": []map[string]interface{}{}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	tree, err = wikijsClient.GetNavigationTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if navigationItems(tree, "de") != nil {
+		t.Errorf("navigation of de was not removed: %+v", tree)
+	}
+}
+
+func navigationItems(tree []wikijs.NavigationTree, locale string) []wikijs.NavigationItem {
+	for _, navigationTree := range tree {
+		if navigationTree.Locale == locale {
+			return navigationTree.Items
+		}
+	}
+	return nil
+}
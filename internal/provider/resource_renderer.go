@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type rendererResourceType struct{}
+
+func (t rendererResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"key": {
+			MarkdownDescription: "Key of the renderer, e.g. `markdownCore`, `markdownKroki` or `htmlSecurity`",
+			Type:                types.StringType,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"title": {
+			MarkdownDescription: "Title of the renderer",
+			Type:                types.StringType,
+			Computed:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"is_enabled": {
+			MarkdownDescription: "Whether the renderer is enabled",
+			Type:                types.BoolType,
+			Required:            true,
+		},
+	}
+	for name, attribute := range moduleConfigAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Renderer of the rendering pipeline, e.g. the markdown core renderer with its line break and typographer options. " +
+			"Destroying the resource leaves the renderer as it is.",
+		Attributes: attributes,
+	}, nil
+}
+
+func (t rendererResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return rendererResource{
+		provider: provider,
+	}, diags
+}
+
+type rendererResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	Config          types.Map    `tfsdk:"config"`
+	SensitiveConfig types.Map    `tfsdk:"sensitive_config"`
+}
+
+func newRendererResourceData(renderer module, prior rendererResourceData) (rendererResourceData, error) {
+	properties, err := wikijs.DecodeModuleConfig(renderer.Config)
+	if err != nil {
+		return prior, err
+	}
+
+	return rendererResourceData{
+		Id:              types.String{Value: renderer.Key},
+		Key:             types.String{Value: renderer.Key},
+		Title:           types.String{Value: renderer.Title},
+		IsEnabled:       types.Bool{Value: renderer.IsEnabled},
+		Config:          newModuleConfig(properties, prior.Config),
+		SensitiveConfig: prior.SensitiveConfig,
+	}, nil
+}
+
+// rendererModules returns the renderers as module list.
+func rendererModules(client *wikijs.WikijsClient) moduleList {
+	return moduleList{
+		name: "renderer",
+		get: func() ([]module, error) {
+			renderers, err := client.GetRenderers()
+			if err != nil {
+				return nil, err
+			}
+			modules := []module{}
+			for _, renderer := range renderers {
+				modules = append(modules, module{Key: renderer.Key, Title: renderer.Title, IsEnabled: renderer.IsEnabled, Config: renderer.Config})
+			}
+			return modules, nil
+		},
+		update: func(modules []module) error {
+			inputs := []wikijs.RendererInput{}
+			for _, module := range modules {
+				inputs = append(inputs, wikijs.RendererInput{IsEnabled: module.IsEnabled, Key: module.Key, Config: module.Config})
+			}
+			return client.UpdateRenderers(inputs)
+		},
+	}
+}
+
+type rendererResource struct {
+	provider provider
+}
+
+func (r rendererResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data rendererResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r rendererResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data rendererResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.Value
+	if data.Key.Null {
+		// imported by key
+		key = data.Id.Value
+	}
+
+	renderer, diags := rendererModules(r.provider.client).read(key)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := newRendererResourceData(*renderer, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read renderer, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r rendererResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data rendererResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+// Delete leaves the renderer as it is, disabling core renderers would break
+// rendering of all pages.
+func (r rendererResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func (r rendererResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply updates the renderer. Enabling a renderer which depends on a
+// disabled one warns, as it has no effect.
+func (r rendererResource) apply(ctx context.Context, data rendererResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.IsEnabled.Value {
+		diags.Append(r.checkDependency(data.Key.Value)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	renderer, moreDiags := rendererModules(r.provider.client).apply(ctx, data.Key.Value, data.IsEnabled.Value, data.Config, data.SensitiveConfig)
+	diags.Append(moreDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data, err := newRendererResourceData(*renderer, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read renderer, got error: %s", err))
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
+
+// checkDependency warns if the renderer with key depends on a disabled
+// renderer. Unknown keys are left to apply.
+func (r rendererResource) checkDependency(key string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	renderers, err := r.provider.client.GetRenderers()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read renderers, got error: %s", err))
+		return diags
+	}
+	enabled := map[string]bool{}
+	for _, renderer := range renderers {
+		enabled[renderer.Key] = renderer.IsEnabled
+	}
+	for _, renderer := range renderers {
+		if renderer.Key != key || renderer.DependsOn == "" {
+			continue
+		}
+		if isEnabled, ok := enabled[renderer.DependsOn]; ok && !isEnabled {
+			diags.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName("is_enabled"), "Disabled Dependency",
+				fmt.Sprintf("Renderer %s depends on %s, which is disabled, so it has no effect", renderer.Key, renderer.DependsOn))
+		}
+	}
+	return diags
+}
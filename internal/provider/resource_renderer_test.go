@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRendererResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRendererResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_renderer.test", "id", "markdownCore"),
+					resource.TestCheckResourceAttr("wikijs_renderer.test", "config.typographer", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_renderer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccRendererResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_renderer.test", "config.typographer", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRendererResourceConfig(typographer string) string {
+	return `
+resource "wikijs_renderer" "test" {
+	key        = "markdownCore"
+	is_enabled = true
+	config = {
+		typographer = "` + typographer + `"
+	}
+}
+`
+}
+
+func TestRendererResource(t *testing.T) {
+	core := newResourceHarness(t, "wikijs_renderer")
+	config := map[string]interface{}{
+		"key":        "markdownCore",
+		"is_enabled": true,
+		"config": map[string]string{
+			"linebreaks":  "false",
+			"typographer": "true",
+			"quotes":      "German",
+		},
+	}
+	if diags := core.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if title := core.attributes()["title"]; title != "Core" {
+		t.Errorf("title = %v, want Core", title)
+	}
+	if diags := core.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := core.planOnly(config)
+	if !reflect.DeepEqual(core.plannedAttributes(plan), core.attributes()) {
+		t.Errorf("expected empty plan, got %v", core.plannedAttributes(plan))
+	}
+
+	kroki := newResourceHarness(t, "wikijs_renderer")
+	krokiConfig := map[string]interface{}{
+		"key":        "markdownKroki",
+		"is_enabled": true,
+		"config":     map[string]string{"server": "https://kroki.example.com"},
+	}
+	if diags := kroki.apply(krokiConfig); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+
+	renderers := newDataSourceHarness(t, "wikijs_renderers")
+	if diags := renderers.readDataSource(map[string]interface{}{"enabled": true}); hasError(diags) {
+		t.Fatalf("read renderers: %v", diags)
+	}
+	dependsOn := map[string]interface{}{}
+	for _, renderer := range renderers.attributes()["renderers"].([]interface{}) {
+		attributes := renderer.(map[string]interface{})
+		if attributes["is_enabled"] != true {
+			t.Errorf("renderer %v is not enabled", attributes["key"])
+		}
+		dependsOn[attributes["key"].(string)] = attributes["depends_on"]
+	}
+	if dependsOn["markdownKroki"] != "markdownCore" || dependsOn["markdownCore"] != "htmlCore" || dependsOn["htmlCore"] != "" {
+		t.Errorf("unexpected dependencies %v", dependsOn)
+	}
+	if _, ok := dependsOn["markdownMathjax"]; ok {
+		t.Errorf("disabled renderer markdownMathjax listed")
+	}
+
+	// Enabling a renderer which depends on a disabled one warns.
+	config["is_enabled"] = false
+	if diags := core.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	krokiConfig["config"] = map[string]string{"server": "https://kroki.example.org"}
+	diags := kroki.apply(krokiConfig)
+	if hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if warnings := diagnosticSummaries(diags, tfprotov6.DiagnosticSeverityWarning); !reflect.DeepEqual(warnings, []string{"Disabled Dependency"}) {
+		t.Errorf("warnings = %v, want [Disabled Dependency]", warnings)
+	}
+	config["is_enabled"] = true
+	if diags := core.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+
+	invalid := []map[string]interface{}{
+		{"key": "markdownCore", "is_enabled": true, "config": map[string]string{"typographer": "sometimes"}},
+		{"key": "markdownCore", "is_enabled": true, "config": map[string]string{"server": "https://kroki.io"}},
+	}
+	for _, config := range invalid {
+		if diags := core.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+	if diags := newResourceHarness(t, "wikijs_renderer").apply(map[string]interface{}{"key": "markdownMagic", "is_enabled": true}); !hasError(diags) {
+		t.Errorf("expected unknown renderer to be invalid")
+	}
+
+	for _, h := range []*testHarness{kroki, core} {
+		if diags := h.destroy(); hasError(diags) {
+			t.Fatalf("destroy: %v", diags)
+		}
+	}
+	renderersAfter, err := wikijsClient.GetRenderers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, renderer := range renderersAfter {
+		if renderer.Key == "markdownKroki" && !renderer.IsEnabled {
+			t.Errorf("expected destroy to leave markdownKroki enabled")
+		}
+	}
+
+	// Restore the default.
+	krokiConfig["is_enabled"] = false
+	if diags := newResourceHarness(t, "wikijs_renderer").apply(krokiConfig); hasError(diags) {
+		t.Fatalf("disable: %v", diags)
+	}
+}
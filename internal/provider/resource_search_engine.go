@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const searchEngineId = "search_engine"
+
+// defaultSearchEngine is the engine Wiki.js uses out of the box, which is
+// activated again when the resource is destroyed.
+const defaultSearchEngine = "db"
+
+type searchEngineResourceType struct{}
+
+func (t searchEngineResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"key": {
+			MarkdownDescription: "Key of the active search engine, one of `db`, `postgres`, `elasticsearch`, `algolia`, `aws`, `azure`, `manticore` or `solr`",
+			Type:                types.StringType,
+			Required:            true,
+		},
+		"title": {
+			MarkdownDescription: "Title of the search engine",
+			Type:                types.StringType,
+			Computed:            true,
+		},
+		"rebuild_index": {
+			MarkdownDescription: "Rebuild the search index when the engine or its config changes",
+			Type:                types.BoolType,
+			Optional:            true,
+		},
+	}
+	for name, attribute := range moduleConfigAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Active search engine and its config. All other engines are disabled, as Wiki.js only uses one. " +
+			"Destroying the resource activates the basic `db` engine again.",
+		Attributes: attributes,
+	}, nil
+}
+
+func (t searchEngineResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return searchEngineResource{
+		provider: provider,
+	}, diags
+}
+
+type searchEngineResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	RebuildIndex    types.Bool   `tfsdk:"rebuild_index"`
+	Config          types.Map    `tfsdk:"config"`
+	SensitiveConfig types.Map    `tfsdk:"sensitive_config"`
+}
+
+func newSearchEngineResourceData(engine module, prior searchEngineResourceData) (searchEngineResourceData, error) {
+	properties, err := wikijs.DecodeModuleConfig(engine.Config)
+	if err != nil {
+		return prior, err
+	}
+
+	return searchEngineResourceData{
+		Id:              types.String{Value: searchEngineId},
+		Key:             types.String{Value: engine.Key},
+		Title:           types.String{Value: engine.Title},
+		RebuildIndex:    prior.RebuildIndex,
+		Config:          newModuleConfig(properties, prior.Config),
+		SensitiveConfig: prior.SensitiveConfig,
+	}, nil
+}
+
+// searchEngineModules returns the search engines as module list.
+func searchEngineModules(client *wikijs.WikijsClient) moduleList {
+	return moduleList{
+		name:   "search engine",
+		single: true,
+		get: func() ([]module, error) {
+			engines, err := client.GetSearchEngines()
+			if err != nil {
+				return nil, err
+			}
+			modules := []module{}
+			for _, engine := range engines {
+				modules = append(modules, module{Key: engine.Key, Title: engine.Title, IsEnabled: engine.IsEnabled, Config: engine.Config})
+			}
+			return modules, nil
+		},
+		update: func(modules []module) error {
+			inputs := []wikijs.SearchEngineInput{}
+			for _, module := range modules {
+				inputs = append(inputs, wikijs.SearchEngineInput{IsEnabled: module.IsEnabled, Key: module.Key, Config: module.Config})
+			}
+			return client.UpdateSearchEngines(inputs)
+		},
+	}
+}
+
+type searchEngineResource struct {
+	provider provider
+}
+
+func (r searchEngineResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data searchEngineResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, data.RebuildIndex.Value, &resp.State)...)
+}
+
+func (r searchEngineResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data searchEngineResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	engine, diags := searchEngineModules(r.provider.client).read("")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if engine == nil {
+		// No engine is active, the cleared key plans to activate it again.
+		data.Key = types.String{Value: ""}
+		data.Title = types.String{Value: ""}
+	} else {
+		var err error
+		data, err = newSearchEngineResourceData(*engine, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read search engine, got error: %s", err))
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r searchEngineResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data, state searchEngineResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := !data.Key.Equal(state.Key) || !data.Config.Equal(state.Config) || !data.SensitiveConfig.Equal(state.SensitiveConfig)
+	resp.Diagnostics.Append(r.apply(ctx, data, data.RebuildIndex.Value && changed, &resp.State)...)
+}
+
+func (r searchEngineResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.Diagnostics.Append(searchEngineModules(r.provider.client).activate(defaultSearchEngine)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r searchEngineResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply enables the engine and disables all others. The index is rebuilt
+// if rebuild is set.
+func (r searchEngineResource) apply(ctx context.Context, data searchEngineResourceData, rebuild bool, state *tfsdk.State) diag.Diagnostics {
+	engine, diags := searchEngineModules(r.provider.client).apply(ctx, data.Key.Value, true, data.Config, data.SensitiveConfig)
+	if diags.HasError() {
+		return diags
+	}
+
+	if rebuild {
+		err := r.provider.client.RebuildSearchIndex()
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to rebuild search index, got error: %s", err))
+			return diags
+		}
+	}
+
+	data, err := newSearchEngineResourceData(*engine, data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read search engine, got error: %s", err))
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSearchEngineResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSearchEngineResourceConfig("english"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_search_engine.test", "id", "search_engine"),
+					resource.TestCheckResourceAttr("wikijs_search_engine.test", "key", "postgres"),
+					resource.TestCheckResourceAttr("wikijs_search_engine.test", "config.dictLanguage", "english"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "wikijs_search_engine.test",
+				ImportState:             true,
+				ImportStateId:           "search_engine",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config", "rebuild_index"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSearchEngineResourceConfig("german"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_search_engine.test", "config.dictLanguage", "german"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSearchEngineResourceConfig(dictLanguage string) string {
+	return `
+resource "wikijs_search_engine" "test" {
+	key           = "postgres"
+	rebuild_index = true
+	config = {
+		dictLanguage = "` + dictLanguage + `"
+	}
+}
+`
+}
+
+// searchEngineRebuilds returns how often the fake rebuilt the search index,
+// or -1 when testing against a real instance.
+func searchEngineRebuilds() int {
+	if testServer == nil {
+		return -1
+	}
+	return testServer.SearchIndexRebuilds()
+}
+
+func TestSearchEngineResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_search_engine")
+
+	rebuilds := searchEngineRebuilds()
+	config := map[string]interface{}{
+		"key":           "algolia",
+		"rebuild_index": true,
+		"config": map[string]string{
+			"appId":     "WIKI42",
+			"indexName": "docs",
+		},
+		"sensitive_config": map[string]string{
+			"apiKey": "s3cret",
+		},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if h.attributes()["title"] != "Algolia" {
+		t.Errorf("title = %v, want Algolia", h.attributes()["title"])
+	}
+	engines, err := wikijsClient.GetSearchEngines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := []string{}
+	for _, engine := range engines {
+		if engine.IsEnabled {
+			enabled = append(enabled, engine.Key)
+		}
+		if engine.Key != "algolia" {
+			continue
+		}
+		properties, err := wikijs.DecodeModuleConfig(engine.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := wikijs.ModuleConfigValues(properties)
+		if values["appId"] != "WIKI42" || values["apiKey"] != "s3cret" {
+			t.Errorf("unexpected config %v", values)
+		}
+	}
+	if !reflect.DeepEqual(enabled, []string{"algolia"}) {
+		t.Errorf("enabled engines = %v, want [algolia]", enabled)
+	}
+	if rebuilds >= 0 && searchEngineRebuilds() != rebuilds+1 {
+		t.Errorf("expected the index to be rebuilt on create")
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Switching the engine rebuilds the index, turning off rebuilds does not.
+	config = map[string]interface{}{
+		"key":           "solr",
+		"rebuild_index": true,
+		"config":        map[string]string{"port": "8984"},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if rebuilds >= 0 && searchEngineRebuilds() != rebuilds+2 {
+		t.Errorf("expected the index to be rebuilt on update")
+	}
+	config["rebuild_index"] = false
+	config["config"] = map[string]string{"port": "8985"}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	if rebuilds >= 0 && searchEngineRebuilds() != rebuilds+2 {
+		t.Errorf("expected no rebuild without rebuild_index")
+	}
+
+	// Another engine activated in Wiki.js is detected.
+	engines, err = wikijsClient.GetSearchEngines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs, err := wikijs.SearchEngineInputs(engines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range inputs {
+		inputs[i].IsEnabled = inputs[i].Key == "db"
+	}
+	if err := wikijsClient.UpdateSearchEngines(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if key := h.attributes()["key"]; key != "db" {
+		t.Errorf("drift not detected, key is %v", key)
+	}
+
+	// With all engines disabled in Wiki.js, the engine is activated again.
+	for i := range inputs {
+		inputs[i].IsEnabled = false
+	}
+	if err := wikijsClient.UpdateSearchEngines(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if key := h.attributes()["key"]; key != "" {
+		t.Errorf("inactive engine not detected, key is %v", key)
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("activate: %v", diags)
+	}
+	if key := h.attributes()["key"]; key != "solr" {
+		t.Errorf("key = %v, want solr", key)
+	}
+	engines, err = wikijsClient.GetSearchEngines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, engine := range engines {
+		if engine.IsEnabled != (engine.Key == "solr") {
+			t.Errorf("expected only solr to be enabled, %s is %v", engine.Key, engine.IsEnabled)
+		}
+	}
+
+	invalid := []map[string]interface{}{
+		{"key": "lucene"},
+		{"key": "algolia", "config": map[string]string{"apiKey": "visible"}},
+		{"key": "solr", "config": map[string]string{"port": "default"}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	engines, err = wikijsClient.GetSearchEngines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, engine := range engines {
+		if engine.IsEnabled != (engine.Key == "db") {
+			t.Errorf("expected only db to be enabled after destroy, %s is %v", engine.Key, engine.IsEnabled)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// securityConfigId is the id of the singleton security configuration.
+const securityConfigId = "security_config"
+
+type securityConfigResourceType struct{}
+
+func (t securityConfigResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	hstsDuration := settingAttribute("Max age of the Strict-Transport-Security header in seconds", types.Int64Type)
+	hstsDuration.Validators = []tfsdk.AttributeValidator{int64AtLeast{min: 0}}
+
+	directives := settingAttribute("Content-Security-Policy directives separated by semicolons, e.g. `default-src 'self'`", types.StringType)
+	directives.Validators = []tfsdk.AttributeValidator{cspDirectives{}}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Security hardening settings. Only configured settings are managed, all others are left untouched. " +
+			"Planning a change which weakens a setting produces a warning. Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"open_redirect":   settingAttribute("Prevent redirects to external sites after login", types.BoolType),
+			"iframe":          settingAttribute("Block embedding the site in iframes on other domains", types.BoolType),
+			"referrer_policy": settingAttribute("Send the `same-origin` referrer policy", types.BoolType),
+			"trust_proxy":     settingAttribute("Trust the `X-Forwarded-*` headers of a reverse proxy. Only enable this behind a proxy which sets them", types.BoolType),
+			"sri":             settingAttribute("Enable subresource integrity", types.BoolType),
+			"hsts":            settingAttribute("Send the Strict-Transport-Security header", types.BoolType),
+			"hsts_duration":   hstsDuration,
+			"csp":             settingAttribute("Send a Content-Security-Policy header", types.BoolType),
+			"csp_directives":  directives,
+		},
+	}, nil
+}
+
+func (t securityConfigResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return securityConfigResource{
+		provider: provider,
+	}, diags
+}
+
+type securityConfigResourceData struct {
+	Id             types.String `tfsdk:"id"`
+	OpenRedirect   types.Bool   `tfsdk:"open_redirect"`
+	Iframe         types.Bool   `tfsdk:"iframe"`
+	ReferrerPolicy types.Bool   `tfsdk:"referrer_policy"`
+	TrustProxy     types.Bool   `tfsdk:"trust_proxy"`
+	SRI            types.Bool   `tfsdk:"sri"`
+	HSTS           types.Bool   `tfsdk:"hsts"`
+	HSTSDuration   types.Int64  `tfsdk:"hsts_duration"`
+	CSP            types.Bool   `tfsdk:"csp"`
+	CSPDirectives  types.String `tfsdk:"csp_directives"`
+}
+
+// variables returns the configured settings.
+func (data securityConfigResourceData) variables() wikijs.UpdateSiteConfigVariables {
+	return wikijs.UpdateSiteConfigVariables{
+		SecurityOpenRedirect:   boolPointer(data.OpenRedirect),
+		SecurityIframe:         boolPointer(data.Iframe),
+		SecurityReferrerPolicy: boolPointer(data.ReferrerPolicy),
+		SecurityTrustProxy:     boolPointer(data.TrustProxy),
+		SecuritySRI:            boolPointer(data.SRI),
+		SecurityHSTS:           boolPointer(data.HSTS),
+		SecurityHSTSDuration:   int64Pointer(data.HSTSDuration),
+		SecurityCSP:            boolPointer(data.CSP),
+		SecurityCSPDirectives:  stringPointer(data.CSPDirectives),
+	}
+}
+
+// weakenings returns a warning for each planned setting which is less secure
+// than the current one. Settings not known yet are skipped.
+func (data securityConfigResourceData) weakenings(current securityConfigResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	disabled := func(attribute string, planned types.Bool, current types.Bool, summary string) {
+		if planned.Null || planned.Unknown || current.Null || current.Unknown {
+			return
+		}
+		if current.Value && !planned.Value {
+			diags.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName(attribute), "Weakened Security Setting", summary)
+		}
+	}
+	disabled("open_redirect", data.OpenRedirect, current.OpenRedirect,
+		"This change allows redirects to external sites after login.")
+	disabled("iframe", data.Iframe, current.Iframe,
+		"This change allows other sites to embed the wiki in iframes, which enables clickjacking.")
+	disabled("referrer_policy", data.ReferrerPolicy, current.ReferrerPolicy,
+		"This change sends page URLs to other sites in the Referer header.")
+	disabled("sri", data.SRI, current.SRI,
+		"This change disables subresource integrity checks.")
+	disabled("hsts", data.HSTS, current.HSTS,
+		"This change stops sending the Strict-Transport-Security header.")
+	disabled("csp", data.CSP, current.CSP,
+		"This change stops sending the Content-Security-Policy header.")
+
+	if !data.TrustProxy.Null && !data.TrustProxy.Unknown && !current.TrustProxy.Null && !current.TrustProxy.Unknown &&
+		data.TrustProxy.Value && !current.TrustProxy.Value {
+		diags.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName("trust_proxy"), "Weakened Security Setting",
+			"This change trusts the X-Forwarded-* headers. Clients can spoof their address unless a reverse proxy sets them.")
+	}
+
+	if !data.HSTSDuration.Null && !data.HSTSDuration.Unknown && !current.HSTSDuration.Null && !current.HSTSDuration.Unknown &&
+		data.HSTSDuration.Value < current.HSTSDuration.Value {
+		diags.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName("hsts_duration"), "Weakened Security Setting",
+			fmt.Sprintf("This change shortens the Strict-Transport-Security max age from %d to %d seconds.", current.HSTSDuration.Value, data.HSTSDuration.Value))
+	}
+
+	return diags
+}
+
+func newSecurityConfigResourceData(siteConfig *wikijs.SiteConfig) securityConfigResourceData {
+	return securityConfigResourceData{
+		Id:             types.String{Value: securityConfigId},
+		OpenRedirect:   types.Bool{Value: siteConfig.SecurityOpenRedirect},
+		Iframe:         types.Bool{Value: siteConfig.SecurityIframe},
+		ReferrerPolicy: types.Bool{Value: siteConfig.SecurityReferrerPolicy},
+		TrustProxy:     types.Bool{Value: siteConfig.SecurityTrustProxy},
+		SRI:            types.Bool{Value: siteConfig.SecuritySRI},
+		HSTS:           types.Bool{Value: siteConfig.SecurityHSTS},
+		HSTSDuration:   types.Int64{Value: siteConfig.SecurityHSTSDuration},
+		CSP:            types.Bool{Value: siteConfig.SecurityCSP},
+		CSPDirectives:  types.String{Value: siteConfig.SecurityCSPDirectives},
+	}
+}
+
+type securityConfigResource struct {
+	provider provider
+}
+
+func (r securityConfigResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data securityConfigResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CSP.Null && !data.CSP.Unknown && !data.CSP.Value &&
+		!data.CSPDirectives.Null && !data.CSPDirectives.Unknown && data.CSPDirectives.Value != "" {
+		resp.Diagnostics.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName("csp_directives"), "Ineffective Attribute",
+			"The directives are not sent while csp is disabled.")
+	}
+	if !data.HSTS.Null && !data.HSTS.Unknown && !data.HSTS.Value &&
+		!data.HSTSDuration.Null && !data.HSTSDuration.Unknown {
+		resp.Diagnostics.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName("hsts_duration"), "Ineffective Attribute",
+			"The duration has no effect while hsts is disabled.")
+	}
+}
+
+// ModifyPlan warns about weakened settings, comparing against the state or,
+// when the resource is created, against the current settings of the site.
+func (r securityConfigResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned securityConfigResourceData
+	diags := req.Plan.Get(ctx, &planned)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current securityConfigResourceData
+	if req.State.Raw.IsNull() {
+		if !r.provider.configured {
+			return
+		}
+		siteConfig, err := r.provider.client.GetSiteConfig()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read site config, got error: %s", err))
+			return
+		}
+		current = newSecurityConfigResourceData(siteConfig)
+	} else {
+		diags = req.State.Get(ctx, &current)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(planned.weakenings(current)...)
+}
+
+func (r securityConfigResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data securityConfigResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r securityConfigResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	siteConfig, err := r.provider.client.GetSiteConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read site config, got error: %s", err))
+		return
+	}
+
+	data := newSecurityConfigResourceData(siteConfig)
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r securityConfigResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data securityConfigResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r securityConfigResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The settings cannot be deleted, so they are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r securityConfigResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply updates the configured settings and stores all settings in state.
+func (r securityConfigResource) apply(ctx context.Context, data securityConfigResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.provider.client.UpdateSiteConfig(data.variables())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update security config, got error: %s", err))
+		return diags
+	}
+
+	siteConfig, err := r.provider.client.GetSiteConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read site config, got error: %s", err))
+		return diags
+	}
+
+	data = newSecurityConfigResourceData(siteConfig)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
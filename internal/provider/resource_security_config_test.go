@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecurityConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecurityConfigResourceConfig(31536000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_security_config.test", "id", "security_config"),
+					resource.TestCheckResourceAttr("wikijs_security_config.test", "hsts", "true"),
+					resource.TestCheckResourceAttr("wikijs_security_config.test", "hsts_duration", "31536000"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "wikijs_security_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSecurityConfigResourceConfig(63072000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_security_config.test", "hsts_duration", "63072000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityConfigResourceConfig(hstsDuration int) string {
+	return fmt.Sprintf(`
+resource "wikijs_security_config" "test" {
+	hsts          = true
+	hsts_duration = %d
+	iframe        = true
+}
+`, hstsDuration)
+}
+
+func TestSecurityConfigResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_security_config")
+
+	diags := h.apply(map[string]interface{}{
+		"hsts":           true,
+		"hsts_duration":  31536000,
+		"csp":            true,
+		"csp_directives": "default-src 'self'; img-src *",
+	})
+	if hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	siteConfig, err := wikijsClient.GetSiteConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !siteConfig.SecurityHSTS || siteConfig.SecurityHSTSDuration != 31536000 || siteConfig.SecurityCSPDirectives != "default-src 'self'; img-src *" {
+		t.Errorf("unexpected site config after create: %+v", siteConfig)
+	}
+
+	// Weakening settings is planned with warnings.
+	plan := h.planOnly(map[string]interface{}{
+		"hsts":          true,
+		"hsts_duration": 300,
+		"iframe":        false,
+		"sri":           true,
+	})
+	warnings := diagnosticSummaries(plan.Diagnostics, tfprotov6.DiagnosticSeverityWarning)
+	if len(warnings) != 2 || hasError(plan.Diagnostics) {
+		t.Errorf("expected 2 weakening warnings, got %v", plan.Diagnostics)
+	}
+
+	// Strengthening settings is planned without warnings.
+	plan = h.planOnly(map[string]interface{}{
+		"hsts_duration": 63072000,
+		"trust_proxy":   false,
+	})
+	if len(plan.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %v", plan.Diagnostics)
+	}
+
+	invalid := []map[string]interface{}{
+		{"hsts_duration": -1},
+		{"csp_directives": "default-src 'self'; 'self' img-src"},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	diags = h.apply(map[string]interface{}{
+		"csp":            false,
+		"csp_directives": "default-src 'self'",
+	})
+	if hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	warnings = diagnosticSummaries(diags, tfprotov6.DiagnosticSeverityWarning)
+	if !reflect.DeepEqual(warnings, []string{"Ineffective Attribute", "Weakened Security Setting"}) {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	siteConfig, err = wikijsClient.GetSiteConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if siteConfig.SecurityHSTSDuration != 31536000 {
+		t.Errorf("destroy changed hsts_duration to %d", siteConfig.SecurityHSTSDuration)
+	}
+}
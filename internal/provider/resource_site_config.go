@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// siteConfigId is the id of the singleton site configuration.
+const siteConfigId = "site_config"
+
+var (
+	// siteHost matches hosts Wiki.js keeps as they are, it trims them and
+	// strips trailing slashes.
+	siteHost = regexp.MustCompile(`^(?s)([^\s/]|\S.*[^\s/])?$`)
+	// siteTitle matches titles Wiki.js keeps as they are, it trims them.
+	siteTitle = regexp.MustCompile(`^(?s)(\S|\S.*\S)?$`)
+	// pageExtension matches extensions Wiki.js keeps as they are, it splits
+	// them at commas, trims and lowercases them and drops empty ones.
+	pageExtension = regexp.MustCompile(`^[^A-Z,\s]([^A-Z,]*[^A-Z,\s])?$`)
+)
+
+type siteConfigResourceType struct{}
+
+func (t siteConfigResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	host := settingAttribute("Site URL without a trailing slash, e.g. `https://wiki.example.com`", types.StringType)
+	host.Validators = []tfsdk.AttributeValidator{
+		stringMatches{pattern: siteHost, description: "a URL without surrounding whitespace or a trailing slash"},
+	}
+	title := settingAttribute("Site title", types.StringType)
+	title.Validators = []tfsdk.AttributeValidator{
+		stringMatches{pattern: siteTitle, description: "a title without surrounding whitespace"},
+	}
+	pageExtensions := settingAttribute("Extensions which are treated as page paths, e.g. `md`, `html`", types.ListType{ElemType: types.StringType})
+	pageExtensions.Validators = []tfsdk.AttributeValidator{
+		stringListMatches{pattern: pageExtension, description: "lowercase extensions without surrounding whitespace or commas"},
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "General site settings. Only configured settings are managed, all others are left untouched. " +
+			"Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"host":                      host,
+			"title":                     title,
+			"description":               settingAttribute("Default description of pages", types.StringType),
+			"robots":                    settingAttribute("Robots directives, e.g. `noindex`, `nofollow`", types.ListType{ElemType: types.StringType}),
+			"analytics_service":         settingAttribute("Analytics service", types.StringType),
+			"analytics_id":              settingAttribute("Analytics ID", types.StringType),
+			"company":                   settingAttribute("Company or organization name shown in the footer", types.StringType),
+			"content_license":           settingAttribute("License of the content shown in the footer, e.g. `cc0`, `ccby`, `alr`", types.StringType),
+			"footer_override":           settingAttribute("Markdown replacing the footer text", types.StringType),
+			"logo_url":                  settingAttribute("URL of the site logo", types.StringType),
+			"page_extensions":           pageExtensions,
+			"auth_auto_login":           settingAttribute("Automatically redirect to the first authentication provider", types.BoolType),
+			"auth_enforce_2fa":          settingAttribute("Enforce two-factor authentication for local accounts", types.BoolType),
+			"auth_hide_local":           settingAttribute("Hide the local authentication provider on the login screen", types.BoolType),
+			"auth_login_bg_url":         settingAttribute("URL of the login screen background image", types.StringType),
+			"auth_jwt_audience":         settingAttribute("Audience of issued JWTs", types.StringType),
+			"auth_jwt_expiration":       settingAttribute("Lifetime of issued JWTs, e.g. `30m`", types.StringType),
+			"auth_jwt_renewable_period": settingAttribute("Period in which an expired JWT can be renewed, e.g. `14d`", types.StringType),
+			"edit_fab":                  settingAttribute("Show the floating edit menu on pages", types.BoolType),
+			"feature_page_ratings":      settingAttribute("Allow users to rate pages", types.BoolType),
+			"feature_page_comments":     settingAttribute("Allow users to comment on pages", types.BoolType),
+			"feature_personal_wikis":    settingAttribute("Allow users to have personal wikis", types.BoolType),
+			"upload_max_file_size":      settingAttribute("Maximum size of uploaded files in bytes", types.Int64Type),
+			"upload_max_files":          settingAttribute("Maximum number of files per upload", types.Int64Type),
+			"upload_scan_svg":           settingAttribute("Sanitize uploaded SVG files", types.BoolType),
+			"upload_force_download":     settingAttribute("Force downloading uploaded files instead of displaying them", types.BoolType),
+		},
+	}, nil
+}
+
+func (t siteConfigResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return siteConfigResource{
+		provider: provider,
+	}, diags
+}
+
+type siteConfigResourceData struct {
+	Id                     types.String `tfsdk:"id"`
+	Host                   types.String `tfsdk:"host"`
+	Title                  types.String `tfsdk:"title"`
+	Description            types.String `tfsdk:"description"`
+	Robots                 types.List   `tfsdk:"robots"`
+	AnalyticsService       types.String `tfsdk:"analytics_service"`
+	AnalyticsId            types.String `tfsdk:"analytics_id"`
+	Company                types.String `tfsdk:"company"`
+	ContentLicense         types.String `tfsdk:"content_license"`
+	FooterOverride         types.String `tfsdk:"footer_override"`
+	LogoUrl                types.String `tfsdk:"logo_url"`
+	PageExtensions         types.List   `tfsdk:"page_extensions"`
+	AuthAutoLogin          types.Bool   `tfsdk:"auth_auto_login"`
+	AuthEnforce2FA         types.Bool   `tfsdk:"auth_enforce_2fa"`
+	AuthHideLocal          types.Bool   `tfsdk:"auth_hide_local"`
+	AuthLoginBgUrl         types.String `tfsdk:"auth_login_bg_url"`
+	AuthJwtAudience        types.String `tfsdk:"auth_jwt_audience"`
+	AuthJwtExpiration      types.String `tfsdk:"auth_jwt_expiration"`
+	AuthJwtRenewablePeriod types.String `tfsdk:"auth_jwt_renewable_period"`
+	EditFab                types.Bool   `tfsdk:"edit_fab"`
+	FeaturePageRatings     types.Bool   `tfsdk:"feature_page_ratings"`
+	FeaturePageComments    types.Bool   `tfsdk:"feature_page_comments"`
+	FeaturePersonalWikis   types.Bool   `tfsdk:"feature_personal_wikis"`
+	UploadMaxFileSize      types.Int64  `tfsdk:"upload_max_file_size"`
+	UploadMaxFiles         types.Int64  `tfsdk:"upload_max_files"`
+	UploadScanSVG          types.Bool   `tfsdk:"upload_scan_svg"`
+	UploadForceDownload    types.Bool   `tfsdk:"upload_force_download"`
+}
+
+// variables returns the configured settings.
+func (data siteConfigResourceData) variables(ctx context.Context) (wikijs.UpdateSiteConfigVariables, diag.Diagnostics) {
+	robots, diags := stringListPointer(ctx, data.Robots)
+	pageExtensions, moreDiags := stringListPointer(ctx, data.PageExtensions)
+	diags.Append(moreDiags...)
+
+	variables := wikijs.UpdateSiteConfigVariables{
+		Host:                   stringPointer(data.Host),
+		Title:                  stringPointer(data.Title),
+		Description:            stringPointer(data.Description),
+		Robots:                 robots,
+		AnalyticsService:       stringPointer(data.AnalyticsService),
+		AnalyticsId:            stringPointer(data.AnalyticsId),
+		Company:                stringPointer(data.Company),
+		ContentLicense:         stringPointer(data.ContentLicense),
+		FooterOverride:         stringPointer(data.FooterOverride),
+		LogoUrl:                stringPointer(data.LogoUrl),
+		AuthAutoLogin:          boolPointer(data.AuthAutoLogin),
+		AuthEnforce2FA:         boolPointer(data.AuthEnforce2FA),
+		AuthHideLocal:          boolPointer(data.AuthHideLocal),
+		AuthLoginBgUrl:         stringPointer(data.AuthLoginBgUrl),
+		AuthJwtAudience:        stringPointer(data.AuthJwtAudience),
+		AuthJwtExpiration:      stringPointer(data.AuthJwtExpiration),
+		AuthJwtRenewablePeriod: stringPointer(data.AuthJwtRenewablePeriod),
+		EditFab:                boolPointer(data.EditFab),
+		FeaturePageRatings:     boolPointer(data.FeaturePageRatings),
+		FeaturePageComments:    boolPointer(data.FeaturePageComments),
+		FeaturePersonalWikis:   boolPointer(data.FeaturePersonalWikis),
+		UploadMaxFileSize:      int64Pointer(data.UploadMaxFileSize),
+		UploadMaxFiles:         int64Pointer(data.UploadMaxFiles),
+		UploadScanSVG:          boolPointer(data.UploadScanSVG),
+		UploadForceDownload:    boolPointer(data.UploadForceDownload),
+	}
+	if pageExtensions != nil {
+		joined := strings.Join(*pageExtensions, ",")
+		variables.PageExtensions = &joined
+	}
+	return variables, diags
+}
+
+func newSiteConfigResourceData(siteConfig *wikijs.SiteConfig) siteConfigResourceData {
+	pageExtensions := []string{}
+	for _, pageExtension := range strings.Split(siteConfig.PageExtensions, ",") {
+		if pageExtension = strings.TrimSpace(pageExtension); pageExtension != "" {
+			pageExtensions = append(pageExtensions, pageExtension)
+		}
+	}
+
+	return siteConfigResourceData{
+		Id:                     types.String{Value: siteConfigId},
+		Host:                   types.String{Value: siteConfig.Host},
+		Title:                  types.String{Value: siteConfig.Title},
+		Description:            types.String{Value: siteConfig.Description},
+		Robots:                 stringList(siteConfig.Robots),
+		AnalyticsService:       types.String{Value: siteConfig.AnalyticsService},
+		AnalyticsId:            types.String{Value: siteConfig.AnalyticsId},
+		Company:                types.String{Value: siteConfig.Company},
+		ContentLicense:         types.String{Value: siteConfig.ContentLicense},
+		FooterOverride:         types.String{Value: siteConfig.FooterOverride},
+		LogoUrl:                types.String{Value: siteConfig.LogoUrl},
+		PageExtensions:         stringList(pageExtensions),
+		AuthAutoLogin:          types.Bool{Value: siteConfig.AuthAutoLogin},
+		AuthEnforce2FA:         types.Bool{Value: siteConfig.AuthEnforce2FA},
+		AuthHideLocal:          types.Bool{Value: siteConfig.AuthHideLocal},
+		AuthLoginBgUrl:         types.String{Value: siteConfig.AuthLoginBgUrl},
+		AuthJwtAudience:        types.String{Value: siteConfig.AuthJwtAudience},
+		AuthJwtExpiration:      types.String{Value: siteConfig.AuthJwtExpiration},
+		AuthJwtRenewablePeriod: types.String{Value: siteConfig.AuthJwtRenewablePeriod},
+		EditFab:                types.Bool{Value: siteConfig.EditFab},
+		FeaturePageRatings:     types.Bool{Value: siteConfig.FeaturePageRatings},
+		FeaturePageComments:    types.Bool{Value: siteConfig.FeaturePageComments},
+		FeaturePersonalWikis:   types.Bool{Value: siteConfig.FeaturePersonalWikis},
+		UploadMaxFileSize:      types.Int64{Value: siteConfig.UploadMaxFileSize},
+		UploadMaxFiles:         types.Int64{Value: siteConfig.UploadMaxFiles},
+		UploadScanSVG:          types.Bool{Value: siteConfig.UploadScanSVG},
+		UploadForceDownload:    types.Bool{Value: siteConfig.UploadForceDownload},
+	}
+}
+
+type siteConfigResource struct {
+	provider provider
+}
+
+func (r siteConfigResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data siteConfigResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r siteConfigResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	siteConfig, err := r.provider.client.GetSiteConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read site config, got error: %s", err))
+		return
+	}
+
+	data := newSiteConfigResourceData(siteConfig)
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r siteConfigResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data siteConfigResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r siteConfigResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The site config cannot be deleted, so the settings are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r siteConfigResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply updates the configured settings and stores all settings in state.
+func (r siteConfigResource) apply(ctx context.Context, data siteConfigResourceData, state *tfsdk.State) diag.Diagnostics {
+	variables, diags := data.variables(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	err := r.provider.client.UpdateSiteConfig(variables)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update site config, got error: %s", err))
+		return diags
+	}
+
+	siteConfig, err := r.provider.client.GetSiteConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read site config, got error: %s", err))
+		return diags
+	}
+
+	data = newSiteConfigResourceData(siteConfig)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
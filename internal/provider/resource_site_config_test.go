@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSiteConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSiteConfigResourceConfig("Example wiki"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_site_config.test", "id", "site_config"),
+					resource.TestCheckResourceAttr("wikijs_site_config.test", "title", "Example wiki"),
+					resource.TestCheckResourceAttr("wikijs_site_config.test", "page_extensions.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "wikijs_site_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSiteConfigResourceConfig("Updated wiki"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_site_config.test", "title", "Updated wiki"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteConfigResourceConfig(title string) string {
+	return `
+resource "wikijs_site_config" "test" {
+	title           = "` + title + `"
+	page_extensions = ["md", "html"]
+}
+`
+}
+
+func TestSiteConfigResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_site_config")
+
+	diags := h.apply(map[string]interface{}{
+		"title":            "Example wiki",
+		"upload_max_files": 5,
+		"robots":           []string{"noindex"},
+	})
+	if hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	attributes := h.attributes()
+	if attributes["id"] != siteConfigId || attributes["title"] != "Example wiki" {
+		t.Fatalf("unexpected state after create: %v", attributes)
+	}
+	if attributes["upload_max_files"].(*big.Float).Cmp(big.NewFloat(5)) != 0 {
+		t.Errorf("upload_max_files = %v, want 5", attributes["upload_max_files"])
+	}
+	// Unconfigured settings are read from the server.
+	if attributes["auth_jwt_audience"] != "urn:wiki.js" {
+		t.Errorf("auth_jwt_audience = %v, want urn:wiki.js", attributes["auth_jwt_audience"])
+	}
+
+	// Settings changed outside of terraform are detected.
+	description := "Changed elsewhere"
+	if err := wikijsClient.UpdateSiteConfig(wikijs.UpdateSiteConfigVariables{Description: &description}); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if h.attributes()["description"] != description {
+		t.Errorf("description = %v, want %s", h.attributes()["description"], description)
+	}
+
+	diags = h.apply(map[string]interface{}{
+		"title":           "Updated wiki",
+		"page_extensions": []string{"md"},
+	})
+	if hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	siteConfig, err := wikijsClient.GetSiteConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if siteConfig.Title != "Updated wiki" || siteConfig.PageExtensions != "md" || siteConfig.Description != description {
+		t.Errorf("unexpected site config after update: %+v", siteConfig)
+	}
+
+	// Values Wiki.js would normalize are rejected, as they would not match
+	// the applied settings.
+	host := siteConfig.Host
+	invalid := []map[string]interface{}{
+		{"host": host + "/"},
+		{"title": " Updated wiki"},
+		{"page_extensions": []string{"md", "HTML"}},
+		{"page_extensions": []string{"md "}},
+		{"page_extensions": []string{"md,html"}},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+	diags = h.apply(map[string]interface{}{
+		"host":            host,
+		"title":           "Updated wiki",
+		"page_extensions": []string{"md", "markdown"},
+	})
+	if hasError(diags) {
+		t.Fatalf("update with normalized values: %v", diags)
+	}
+
+	imported := newResourceHarness(t, "wikijs_site_config")
+	if diags := imported.importState(siteConfigId); hasError(diags) {
+		t.Fatalf("import: %v", diags)
+	}
+	if imported.attributes()["title"] != "Updated wiki" {
+		t.Errorf("imported title = %v, want Updated wiki", imported.attributes()["title"])
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	siteConfig, err = wikijsClient.GetSiteConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if siteConfig.Title != "Updated wiki" {
+		t.Errorf("destroy changed the title to %s", siteConfig.Title)
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type sslResourceType struct{}
+
+func (t sslResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	computed := func(description string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			MarkdownDescription: description,
+			Type:                types.StringType,
+			Computed:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		}
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "HTTP to HTTPS redirection and renewal of the Let's Encrypt certificate managed by Wiki.js. " +
+			"Requires Wiki.js 2.5 or later. Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"http_redirection": settingAttribute("Whether HTTP requests are redirected to HTTPS", types.BoolType),
+			"renew_triggers": {
+				MarkdownDescription: "Arbitrary values which renew the Let's Encrypt certificate when changed. " +
+					"The certificate is not renewed when the resource is created",
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"ssl_provider": computed("SSL provider, `letsencrypt` if Wiki.js manages the certificate or `custom`"),
+			"domain":       computed("Domain of the Let's Encrypt certificate"),
+			"expiration_date": {
+				MarkdownDescription: "Expiration date of the Let's Encrypt certificate, e.g. `2030-01-01T00:00:00Z`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t sslResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return sslResource{
+		provider: provider,
+	}, diags
+}
+
+type sslResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	HttpRedirection types.Bool   `tfsdk:"http_redirection"`
+	RenewTriggers   types.Map    `tfsdk:"renew_triggers"`
+	Provider        types.String `tfsdk:"ssl_provider"`
+	Domain          types.String `tfsdk:"domain"`
+	ExpirationDate  types.String `tfsdk:"expiration_date"`
+}
+
+func newSSLResourceData(info *wikijs.SSLInfo, renewTriggers types.Map) sslResourceData {
+	return sslResourceData{
+		Id:              types.String{Value: "ssl"},
+		HttpRedirection: types.Bool{Value: info.HttpRedirection},
+		RenewTriggers:   renewTriggers,
+		Provider:        types.String{Value: info.Provider},
+		Domain:          types.String{Value: info.Domain},
+		ExpirationDate:  types.String{Value: info.ExpirationDate},
+	}
+}
+
+type sslResource struct {
+	provider provider
+}
+
+func (r sslResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data sslResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, false, &resp.State)...)
+}
+
+func (r sslResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data sslResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := r.provider.client.GetSSLInfo()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSL info, got error: %s", err))
+		return
+	}
+
+	data = newSSLResourceData(info, data.RenewTriggers)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r sslResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data, state sslResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	renew := !data.RenewTriggers.Null && !data.RenewTriggers.Equal(state.RenewTriggers)
+	resp.Diagnostics.Append(r.apply(ctx, data, renew, &resp.State)...)
+}
+
+func (r sslResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The settings cannot be deleted, so they are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r sslResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply sets the redirection if it is configured, renews the certificate if
+// requested and stores the resulting settings in state.
+func (r sslResource) apply(ctx context.Context, data sslResourceData, renew bool, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if httpRedirection := boolPointer(data.HttpRedirection); httpRedirection != nil {
+		err := r.provider.client.SetHTTPSRedirection(*httpRedirection)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set HTTPS redirection, got error: %s", err))
+			return diags
+		}
+	}
+
+	if renew {
+		err := r.provider.client.RenewHTTPSCertificate()
+		if err != nil {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("renew_triggers"), "Client Error",
+				fmt.Sprintf("Unable to renew the HTTPS certificate, got error: %s", err))
+			return diags
+		}
+	}
+
+	info, err := r.provider.client.GetSSLInfo()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read SSL info, got error: %s", err))
+		return diags
+	}
+
+	data = newSSLResourceData(info, data.RenewTriggers)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSSLDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "wikijs_ssl" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wikijs_ssl.test", "id", "ssl"),
+					resource.TestCheckResourceAttrSet("data.wikijs_ssl.test", "http_redirection"),
+				),
+			},
+		},
+	})
+}
+
+func TestSSLResource(t *testing.T) {
+	if testServer == nil {
+		t.Skip("certificates can only be renewed with the fake Wiki.js server")
+	}
+
+	h := newResourceHarness(t, "wikijs_ssl")
+	config := map[string]interface{}{
+		"http_redirection": true,
+		"renew_triggers":   map[string]string{"rotation": "1"},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	info, err := wikijsClient.GetSSLInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.HttpRedirection {
+		t.Errorf("HTTPS redirection not enabled")
+	}
+	if testServer.SSLRenewals() != 0 {
+		t.Errorf("certificate renewed on create")
+	}
+	expiration := h.attributes()["expiration_date"]
+	if expiration != info.ExpirationDate || h.attributes()["ssl_provider"] != "letsencrypt" {
+		t.Errorf("unexpected attributes %v", h.attributes())
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Changing the triggers renews the certificate.
+	config["renew_triggers"] = map[string]string{"rotation": "2"}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("renew: %v", diags)
+	}
+	if testServer.SSLRenewals() != 1 {
+		t.Errorf("renewals = %d, want 1", testServer.SSLRenewals())
+	}
+	if h.attributes()["expiration_date"] == expiration {
+		t.Errorf("expiration_date not updated after renewal")
+	}
+
+	data := newDataSourceHarness(t, "wikijs_ssl")
+	if diags := data.readDataSource(map[string]interface{}{}); hasError(diags) {
+		t.Fatalf("read data source: %v", diags)
+	}
+	if data.attributes()["expiration_date"] != h.attributes()["expiration_date"] || data.attributes()["http_redirection"] != true {
+		t.Errorf("unexpected data source attributes %v", data.attributes())
+	}
+
+	// Renewal fails without Let's Encrypt.
+	testServer.SetSSLProvider("custom")
+	defer testServer.SetSSLProvider("letsencrypt")
+	config["renew_triggers"] = map[string]string{"rotation": "3"}
+	if diags := h.apply(config); !hasError(diags) {
+		t.Errorf("expected renewal with a custom certificate to fail")
+	}
+
+	config = map[string]interface{}{"http_redirection": false}
+	if diags := newResourceHarness(t, "wikijs_ssl").apply(config); hasError(diags) {
+		t.Fatalf("disable redirection: %v", diags)
+	}
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	if info, err := wikijsClient.GetSSLInfo(); err != nil || info.HttpRedirection {
+		t.Errorf("HTTPS redirection changed on destroy: %v, %v", info, err)
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type storageActionResourceType struct{}
+
+func (t storageActionResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Runs an action of a storage target, e.g. `sync` of git, when the resource is created or `triggers` change. " +
+			"Wiki.js runs the action before responding, so the apply fails if the action fails. The result of the action is only known from that response, " +
+			"the storage status of the target is set by its scheduled sync and is not read. Destroying the resource does nothing.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Identifier of the action, `<target_key>/<handler>`",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"target_key": {
+				MarkdownDescription: "Key of the enabled storage target, e.g. `git`",
+				Type:                types.StringType,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"handler": {
+				MarkdownDescription: "Action to run, e.g. `sync`, `importAll`, `syncUntracked` or `purge` for git, or `dump`, `backup` or `importAll` for disk",
+				Type:                types.StringType,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary values which run the action again when changed",
+				Type:                types.MapType{ElemType: types.StringType},
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t storageActionResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return storageActionResource{
+		provider: provider,
+	}, diags
+}
+
+type storageActionResourceData struct {
+	Id        types.String `tfsdk:"id"`
+	TargetKey types.String `tfsdk:"target_key"`
+	Handler   types.String `tfsdk:"handler"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+}
+
+type storageActionResource struct {
+	provider provider
+}
+
+func (r storageActionResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data storageActionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, err := r.provider.client.GetStorageTargets()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return
+	}
+	i, err := findStorageTarget(targets, data.TargetKey.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("target_key"), "Unknown Storage Target", err.Error())
+		return
+	}
+	target := targets[i]
+	if !target.IsEnabled {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("target_key"), "Storage Target Disabled",
+			fmt.Sprintf("Storage target %s must be enabled to run actions", target.Key))
+		return
+	}
+	handlers := []string{}
+	found := false
+	for _, action := range target.Actions {
+		handlers = append(handlers, action.Handler)
+		found = found || action.Handler == data.Handler.Value
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("handler"), "Unknown Storage Action",
+			fmt.Sprintf("Storage target %s supports the actions: %s", target.Key, strings.Join(handlers, ", ")))
+		return
+	}
+
+	err = r.provider.client.ExecuteStorageAction(data.TargetKey.Value, data.Handler.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Storage Action Failed", fmt.Sprintf("Storage action %s of %s failed, got error: %s", data.Handler.Value, data.TargetKey.Value, err))
+		return
+	}
+
+	data.Id = types.String{Value: data.TargetKey.Value + "/" + data.Handler.Value}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state. An action leaves nothing in Wiki.js to refresh: its
+// result was that of executeAction when it ran, and the storage status only
+// tells about the scheduled sync of the target.
+func (r storageActionResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+func (r storageActionResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data storageActionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configured attributes require replacement, so there is nothing to run.
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r storageActionResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageActionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStorageActionResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_storage_action.test", "id", "disk/dump"),
+				),
+			},
+			// Changed triggers run the action again
+			{
+				Config: testAccStorageActionResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_storage_action.test", "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageActionResourceConfig(run string) string {
+	return `
+resource "wikijs_storage_target" "test" {
+	key        = "disk"
+	is_enabled = true
+	config = {
+		path = "/wiki-backup"
+	}
+}
+
+resource "wikijs_storage_action" "test" {
+	target_key = wikijs_storage_target.test.key
+	handler    = "dump"
+	triggers = {
+		run = "` + run + `"
+	}
+}
+`
+}
+
+func TestStorageActionResource(t *testing.T) {
+	if testServer == nil {
+		t.Skip("running storage actions needs the fake Wiki.js server")
+	}
+	target := newResourceHarness(t, "wikijs_storage_target")
+	if diags := target.apply(map[string]interface{}{"key": "git", "is_enabled": true}); hasError(diags) {
+		t.Fatalf("enable target: %v", diags)
+	}
+	defer target.destroy()
+
+	h := newResourceHarness(t, "wikijs_storage_action")
+	config := map[string]interface{}{
+		"target_key": "git",
+		"handler":    "sync",
+		"triggers":   map[string]string{"commit": "abc123"},
+	}
+	testServer.SetStorageStatus("git", wikijs.StorageStatusOperational, "")
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	attributes := h.attributes()
+	if attributes["id"] != "git/sync" {
+		t.Errorf("unexpected state %v", attributes)
+	}
+	if actions := testServer.ExecutedStorageActions(); !reflect.DeepEqual(actions, []string{"git/sync"}) {
+		t.Errorf("executed actions = %v, want [git/sync]", actions)
+	}
+
+	// Unchanged triggers do not run the action again.
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+	if actions := testServer.ExecutedStorageActions(); len(actions) != 1 {
+		t.Errorf("expected the action to run once, got %v", actions)
+	}
+
+	config["triggers"] = map[string]string{"commit": "def456"}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("replace: %v", diags)
+	}
+	if actions := testServer.ExecutedStorageActions(); len(actions) != 2 {
+		t.Errorf("expected changed triggers to run the action again, got %v", actions)
+	}
+
+	// A leftover error of the scheduled sync does not fail the action.
+	testServer.SetStorageStatus("git", wikijs.StorageStatusError, "Authentication failed")
+	config["triggers"] = map[string]string{"commit": "9f8e7d"}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("replace with leftover error: %v", diags)
+	}
+
+	// A failed sync fails the apply, whatever the status reports.
+	testServer.SetStorageStatus("git", wikijs.StorageStatusOperational, "")
+	testServer.FailStorageActions("Authentication failed")
+	defer testServer.FailStorageActions("")
+	config["triggers"] = map[string]string{"commit": "0a1b2c"}
+	diags := h.apply(config)
+	if !hasError(diags) {
+		t.Errorf("expected the failed sync to fail the apply")
+	}
+	testServer.FailStorageActions("")
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("retry: %v", diags)
+	}
+
+	invalid := []map[string]interface{}{
+		{"target_key": "git", "handler": "upload"},
+		{"target_key": "s3", "handler": "exportAll"},
+		{"target_key": "floppy", "handler": "sync"},
+	}
+	for _, config := range invalid {
+		if diags := newResourceHarness(t, "wikijs_storage_action").apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+}
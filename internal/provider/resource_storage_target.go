@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// isoDuration matches ISO 8601 durations like `PT5M` or `P1D`.
+var isoDuration = regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+S)?)?$`)
+
+type storageTargetResourceType struct{}
+
+func (t storageTargetResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	mode := settingAttribute("Sync direction, one of `sync` (bi-directional), `push` (to the target) or `pull` (from the target). Targets support different modes", types.StringType)
+	mode.Validators = []tfsdk.AttributeValidator{stringOneOf{values: []string{"sync", "push", "pull"}}}
+
+	syncInterval := settingAttribute("Interval between syncs as ISO 8601 duration, e.g. `PT5M`", types.StringType)
+	syncInterval.Validators = []tfsdk.AttributeValidator{isoDurationValidator{}}
+
+	attributes := map[string]tfsdk.Attribute{
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"key": {
+			MarkdownDescription: "Key of the storage target, e.g. `git`, `s3`, `azure`, `sftp` or `disk`",
+			Type:                types.StringType,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"title": {
+			MarkdownDescription: "Title of the storage target",
+			Type:                types.StringType,
+			Computed:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"is_enabled": {
+			MarkdownDescription: "Whether the storage target is enabled",
+			Type:                types.BoolType,
+			Required:            true,
+		},
+		"mode":          mode,
+		"sync_interval": syncInterval,
+	}
+	for name, attribute := range moduleConfigAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage target to back up and sync content to, e.g. git. Destroying the resource disables the target.",
+		Attributes:          attributes,
+	}, nil
+}
+
+func (t storageTargetResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return storageTargetResource{
+		provider: provider,
+	}, diags
+}
+
+// isoDurationValidator validates that a string attribute is an ISO 8601
+// duration.
+type isoDurationValidator struct{}
+
+func (v isoDurationValidator) Description(ctx context.Context) string {
+	return "value must be an ISO 8601 duration, e.g. PT5M"
+}
+
+func (v isoDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an ISO 8601 duration, e.g. `PT5M`"
+}
+
+func (v isoDurationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+	if !isoDuration.MatchString(value.Value) || value.Value == "P" || strings.HasSuffix(value.Value, "T") {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s, got: %q", v.Description(ctx), value.Value))
+	}
+}
+
+type storageTargetResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Key             types.String `tfsdk:"key"`
+	Title           types.String `tfsdk:"title"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	Mode            types.String `tfsdk:"mode"`
+	SyncInterval    types.String `tfsdk:"sync_interval"`
+	Config          types.Map    `tfsdk:"config"`
+	SensitiveConfig types.Map    `tfsdk:"sensitive_config"`
+}
+
+func newStorageTargetResourceData(target wikijs.StorageTarget, prior storageTargetResourceData) (storageTargetResourceData, error) {
+	properties, err := wikijs.DecodeModuleConfig(target.Config)
+	if err != nil {
+		return prior, err
+	}
+
+	return storageTargetResourceData{
+		Id:              types.String{Value: target.Key},
+		Key:             types.String{Value: target.Key},
+		Title:           types.String{Value: target.Title},
+		IsEnabled:       types.Bool{Value: target.IsEnabled},
+		Mode:            types.String{Value: target.Mode},
+		SyncInterval:    types.String{Value: target.SyncInterval},
+		Config:          newModuleConfig(properties, prior.Config),
+		SensitiveConfig: prior.SensitiveConfig,
+	}, nil
+}
+
+// findStorageTarget returns the index of the target with key.
+func findStorageTarget(targets []wikijs.StorageTarget, key string) (int, error) {
+	keys := []string{}
+	for i, target := range targets {
+		if target.Key == key {
+			return i, nil
+		}
+		keys = append(keys, target.Key)
+	}
+	return -1, fmt.Errorf("storage target %s does not exist, valid keys are: %s", key, strings.Join(keys, ", "))
+}
+
+type storageTargetResource struct {
+	provider provider
+}
+
+func (r storageTargetResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data storageTargetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r storageTargetResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data storageTargetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.Value
+	if data.Key.Null {
+		// imported by key
+		key = data.Id.Value
+	}
+
+	targets, err := r.provider.client.GetStorageTargets()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return
+	}
+	i, err := findStorageTarget(targets, key)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage target, got error: %s", err))
+		return
+	}
+
+	data, err = newStorageTargetResourceData(targets[i], data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage target, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r storageTargetResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data storageTargetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r storageTargetResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data storageTargetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Storage targets cannot be deleted, so the target is disabled.
+	targets, err := r.provider.client.GetStorageTargets()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return
+	}
+	inputs, err := wikijs.StorageTargetInputs(targets)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return
+	}
+	for i := range inputs {
+		if inputs[i].Key == data.Key.Value {
+			inputs[i].IsEnabled = false
+		}
+	}
+
+	err = r.provider.client.UpdateStorageTargets(inputs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable storage target, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r storageTargetResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply updates the target, keeping all other targets as they are since
+// Wiki.js expects the full list of targets.
+func (r storageTargetResource) apply(ctx context.Context, data storageTargetResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	targets, err := r.provider.client.GetStorageTargets()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return diags
+	}
+	i, err := findStorageTarget(targets, data.Key.Value)
+	if err != nil {
+		diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("key"), "Unknown Storage Target", err.Error())
+		return diags
+	}
+	target := targets[i]
+
+	inputs, err := wikijs.StorageTargetInputs(targets)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return diags
+	}
+	input := &inputs[i]
+
+	input.IsEnabled = data.IsEnabled.Value
+	if mode := stringPointer(data.Mode); mode != nil {
+		supported := false
+		for _, supportedMode := range target.SupportedModes {
+			supported = supported || supportedMode == *mode
+		}
+		if !supported {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("mode"), "Unsupported Mode",
+				fmt.Sprintf("Storage target %s supports the modes: %s", target.Key, strings.Join(target.SupportedModes, ", ")))
+			return diags
+		}
+		input.Mode = *mode
+	}
+	if syncInterval := stringPointer(data.SyncInterval); syncInterval != nil {
+		input.SyncInterval = *syncInterval
+	}
+
+	properties, err := wikijs.DecodeModuleConfig(target.Config)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read storage target, got error: %s", err))
+		return diags
+	}
+	values, moreDiags := moduleConfig(ctx, properties, data.Config, data.SensitiveConfig)
+	diags.Append(moreDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	input.Config, err = wikijs.EncodeModuleConfig(values)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode storage target config, got error: %s", err))
+		return diags
+	}
+
+	err = r.provider.client.UpdateStorageTargets(inputs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update storage target, got error: %s", err))
+		return diags
+	}
+
+	targets, err = r.provider.client.GetStorageTargets()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read storage targets, got error: %s", err))
+		return diags
+	}
+	i, err = findStorageTarget(targets, data.Key.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read storage target, got error: %s", err))
+		return diags
+	}
+
+	data, err = newStorageTargetResourceData(targets[i], data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read storage target, got error: %s", err))
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccStorageTargetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStorageTargetResourceConfig("PT12H"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_storage_target.test", "id", "disk"),
+					resource.TestCheckResourceAttr("wikijs_storage_target.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("wikijs_storage_target.test", "config.path", "/wiki-backup"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccStorageTargetResourceConfig("P1D"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_storage_target.test", "sync_interval", "P1D"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageTargetResourceConfig(syncInterval string) string {
+	return `
+resource "wikijs_storage_target" "test" {
+	key           = "disk"
+	is_enabled    = true
+	sync_interval = "` + syncInterval + `"
+	config = {
+		path               = "/wiki-backup"
+		createDailyBackups = "true"
+	}
+}
+`
+}
+
+func TestStorageTargetResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_storage_target")
+
+	config := map[string]interface{}{
+		"key":           "git",
+		"is_enabled":    true,
+		"mode":          "push",
+		"sync_interval": "PT10M",
+		"config": map[string]string{
+			"authType":  "basic",
+			"repoUrl":   "https://git.example.com/wiki.git",
+			"branch":    "main",
+			"verifySSL": "false",
+		},
+		"sensitive_config": map[string]string{
+			"basicPassword": "s3cret",
+		},
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	values := storageTargetConfig(t, "git")
+	expected := map[string]interface{}{
+		"branch":        "main",
+		"verifySSL":     false,
+		"basicPassword": "s3cret",
+		"defaultName":   "John Smith",
+	}
+	for key, value := range expected {
+		if values[key] != value {
+			t.Errorf("config %s = %v, want %v", key, values[key], value)
+		}
+	}
+	if h.attributes()["title"] != "Git" {
+		t.Errorf("title = %v, want Git", h.attributes()["title"])
+	}
+
+	// Other targets are kept.
+	targets, err := wikijsClient.GetStorageTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) < 2 {
+		t.Errorf("expected all targets to be kept, got %d", len(targets))
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// Config changed in Wiki.js is detected.
+	inputs, err := wikijs.StorageTargetInputs(targets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range inputs {
+		if inputs[i].Key == "git" {
+			values["branch"] = "develop"
+			inputs[i].Config, _ = wikijs.EncodeModuleConfig(values)
+		}
+	}
+	if err := wikijsClient.UpdateStorageTargets(inputs); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if branch := h.attributes()["config"].(map[string]interface{})["branch"]; branch != "develop" {
+		t.Errorf("drift not detected, branch is %v", branch)
+	}
+
+	invalid := []map[string]interface{}{
+		{"key": "git", "is_enabled": true, "sync_interval": "5 minutes"},
+		{"key": "git", "is_enabled": true, "config": map[string]string{"unknown": "value"}},
+		{"key": "git", "is_enabled": true, "config": map[string]string{"basicPassword": "visible"}},
+		{"key": "git", "is_enabled": true, "config": map[string]string{"verifySSL": "maybe"}},
+		{"key": "git", "is_enabled": true, "mode": "upload"},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+	invalid = []map[string]interface{}{
+		{"key": "disk", "is_enabled": true, "mode": "pull"},
+		{"key": "floppy", "is_enabled": true},
+	}
+	for _, config := range invalid {
+		if diags := newResourceHarness(t, "wikijs_storage_target").apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	targets, err = wikijsClient.GetStorageTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, target := range targets {
+		if target.Key == "git" && target.IsEnabled {
+			t.Errorf("git target is still enabled")
+		}
+	}
+}
+
+func storageTargetConfig(t *testing.T, key string) map[string]interface{} {
+	targets, err := wikijsClient.GetStorageTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, target := range targets {
+		if target.Key == key {
+			properties, err := wikijs.DecodeModuleConfig(target.Config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return wikijs.ModuleConfigValues(properties)
+		}
+	}
+	t.Fatalf("storage target %s does not exist", key)
+	return nil
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type systemFlagsResourceType struct{}
+
+func (t systemFlagsResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Debug and experimental flags of Wiki.js, e.g. `ldapdebug`. Flags which are removed from the resource, " +
+			"or all of its flags when it is destroyed, are set back to the value they had before.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"flags": {
+				MarkdownDescription: "Values of the flags by key, e.g. `{ ldapdebug = true }`. Flags which are not listed keep their value",
+				Type:                types.MapType{ElemType: types.BoolType},
+				Required:            true,
+			},
+			"original_flags": {
+				MarkdownDescription: "Values the flags had before the resource managed them, which are restored on destroy",
+				Type:                types.MapType{ElemType: types.BoolType},
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t systemFlagsResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return systemFlagsResource{
+		provider: provider,
+	}, diags
+}
+
+type systemFlagsResourceData struct {
+	Id            types.String `tfsdk:"id"`
+	Flags         types.Map    `tfsdk:"flags"`
+	OriginalFlags types.Map    `tfsdk:"original_flags"`
+}
+
+// boolMapValues returns the known values of a map of bools.
+func boolMapValues(value types.Map) map[string]bool {
+	values := map[string]bool{}
+	for key, element := range value.Elems {
+		if element, ok := element.(types.Bool); ok && !element.Unknown && !element.Null {
+			values[key] = element.Value
+		}
+	}
+	return values
+}
+
+func newBoolMap(values map[string]bool) types.Map {
+	elems := map[string]attr.Value{}
+	for key, value := range values {
+		elems[key] = types.Bool{Value: value}
+	}
+	return types.Map{ElemType: types.BoolType, Elems: elems}
+}
+
+// systemFlagValues returns the flags by key and their sorted keys.
+func systemFlagValues(flags []wikijs.SystemFlag) (map[string]bool, []string) {
+	values := map[string]bool{}
+	keys := []string{}
+	for _, flag := range flags {
+		values[flag.Key] = flag.Value
+		keys = append(keys, flag.Key)
+	}
+	sort.Strings(keys)
+	return values, keys
+}
+
+type systemFlagsResource struct {
+	provider provider
+}
+
+func (r systemFlagsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data systemFlagsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data.Flags, map[string]bool{}, map[string]bool{}, &resp.State)...)
+}
+
+func (r systemFlagsResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data systemFlagsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flags, err := r.provider.client.GetSystemFlags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system flags, got error: %s", err))
+		return
+	}
+	current, _ := systemFlagValues(flags)
+
+	// Imported resources manage all flags, with their current values as
+	// original values.
+	managed := boolMapValues(data.Flags)
+	original := boolMapValues(data.OriginalFlags)
+	if data.Flags.Null {
+		managed = current
+		original = current
+	}
+
+	values := map[string]bool{}
+	originalValues := map[string]bool{}
+	for key := range managed {
+		value, ok := current[key]
+		if !ok {
+			continue
+		}
+		values[key] = value
+		if originalValue, ok := original[key]; ok {
+			originalValues[key] = originalValue
+		}
+	}
+
+	data = systemFlagsResourceData{
+		Id:            types.String{Value: "system_flags"},
+		Flags:         newBoolMap(values),
+		OriginalFlags: newBoolMap(originalValues),
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r systemFlagsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data, state systemFlagsResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Flags removed from the resource are restored.
+	original := boolMapValues(state.OriginalFlags)
+	restore := map[string]bool{}
+	for key := range boolMapValues(state.Flags) {
+		if _, ok := data.Flags.Elems[key]; ok {
+			continue
+		}
+		if value, ok := original[key]; ok {
+			restore[key] = value
+		}
+		delete(original, key)
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data.Flags, original, restore, &resp.State)...)
+}
+
+func (r systemFlagsResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data systemFlagsResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flags, err := r.provider.client.GetSystemFlags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system flags, got error: %s", err))
+		return
+	}
+	current, keys := systemFlagValues(flags)
+	for key, value := range boolMapValues(data.OriginalFlags) {
+		if _, ok := current[key]; ok {
+			current[key] = value
+		}
+	}
+
+	err = r.provider.client.UpdateSystemFlags(systemFlagList(current, keys))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore system flags, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r systemFlagsResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply sets the configured flags and the restored ones, keeping all others
+// since Wiki.js replaces all flags. The original value of newly managed flags
+// is added to original.
+func (r systemFlagsResource) apply(ctx context.Context, configured types.Map, original, restore map[string]bool, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	flags, err := r.provider.client.GetSystemFlags()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read system flags, got error: %s", err))
+		return diags
+	}
+	current, keys := systemFlagValues(flags)
+
+	values := map[string]bool{}
+	for key, value := range current {
+		values[key] = value
+	}
+	for key, value := range restore {
+		if _, ok := values[key]; ok {
+			values[key] = value
+		}
+	}
+	for key, value := range boolMapValues(configured) {
+		currentValue, ok := current[key]
+		if !ok {
+			diags.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("flags").WithElementKeyString(key), "Unknown Flag",
+				fmt.Sprintf("Flag %s does not exist, valid flags are: %s", key, strings.Join(keys, ", ")))
+			continue
+		}
+		if _, ok := original[key]; !ok {
+			original[key] = currentValue
+		}
+		values[key] = value
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	err = r.provider.client.UpdateSystemFlags(systemFlagList(values, keys))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update system flags, got error: %s", err))
+		return diags
+	}
+
+	flags, err = r.provider.client.GetSystemFlags()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read system flags, got error: %s", err))
+		return diags
+	}
+	current, _ = systemFlagValues(flags)
+	managed := map[string]bool{}
+	for key := range configured.Elems {
+		managed[key] = current[key]
+	}
+
+	data := systemFlagsResourceData{
+		Id:            types.String{Value: "system_flags"},
+		Flags:         newBoolMap(managed),
+		OriginalFlags: newBoolMap(original),
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
+
+// systemFlagList returns the flags in the order of keys.
+func systemFlagList(values map[string]bool, keys []string) []wikijs.SystemFlag {
+	flags := []wikijs.SystemFlag{}
+	for _, key := range keys {
+		flags = append(flags, wikijs.SystemFlag{Key: key, Value: values[key]})
+	}
+	return flags
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSystemFlagsResource(t *testing.T) {
+	original := systemFlags(t)
+	if _, ok := original["sqllog"]; !ok {
+		t.Skip("the server has no sqllog flag")
+	}
+
+	h := newResourceHarness(t, "wikijs_system_flags")
+	config := map[string]interface{}{"flags": map[string]interface{}{"sqllog": !original["sqllog"]}}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if flags := systemFlags(t); flags["sqllog"] == original["sqllog"] || flags["ldapdebug"] != original["ldapdebug"] {
+		t.Errorf("unexpected flags after create: %v", flags)
+	}
+	if got := h.attributes()["original_flags"]; !reflect.DeepEqual(got, map[string]interface{}{"sqllog": original["sqllog"]}) {
+		t.Errorf("original_flags = %v", got)
+	}
+
+	config = map[string]interface{}{"flags": map[string]interface{}{"sqllog": !original["sqllog"], "ldapdebug": !original["ldapdebug"]}}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("add flag: %v", diags)
+	}
+	if flags := systemFlags(t); flags["sqllog"] == original["sqllog"] || flags["ldapdebug"] == original["ldapdebug"] {
+		t.Errorf("unexpected flags after adding ldapdebug: %v", flags)
+	}
+
+	// Flags removed from the resource are restored.
+	config = map[string]interface{}{"flags": map[string]interface{}{"ldapdebug": !original["ldapdebug"]}}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("remove flag: %v", diags)
+	}
+	if flags := systemFlags(t); flags["sqllog"] != original["sqllog"] || flags["ldapdebug"] == original["ldapdebug"] {
+		t.Errorf("unexpected flags after removing sqllog: %v", flags)
+	}
+	if got := h.attributes()["original_flags"]; !reflect.DeepEqual(got, map[string]interface{}{"ldapdebug": original["ldapdebug"]}) {
+		t.Errorf("original_flags = %v", got)
+	}
+
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	imported := newResourceHarness(t, "wikijs_system_flags")
+	if diags := imported.importState("system_flags"); hasError(diags) {
+		t.Fatalf("import: %v", diags)
+	}
+	if flags := imported.attributes()["flags"].(map[string]interface{}); len(flags) != len(original) {
+		t.Errorf("imported flags = %v, want all flags", flags)
+	}
+
+	invalid := map[string]interface{}{"flags": map[string]interface{}{"nosuchflag": true}}
+	if diags := newResourceHarness(t, "wikijs_system_flags").apply(invalid); !hasError(diags) {
+		t.Errorf("expected unknown flag to be invalid")
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	if flags := systemFlags(t); !reflect.DeepEqual(flags, original) {
+		t.Errorf("flags after destroy = %v, want %v", flags, original)
+	}
+}
+
+func systemFlags(t *testing.T) map[string]bool {
+	flags, err := wikijsClient.GetSystemFlags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]bool{}
+	for _, flag := range flags {
+		values[flag.Key] = flag.Value
+	}
+	return values
+}
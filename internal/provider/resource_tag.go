@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type tagResourceType struct{}
+
+func (t tagResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Title of a page tag. Tags are created by adding them to pages, so the tag must exist. " +
+			"Destroying the resource deletes the tag if no page uses it, otherwise its title is reset to the tag.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"tag": {
+				MarkdownDescription: "Tag as set on pages, e.g. `runbook`",
+				Type:                types.StringType,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"title": {
+				MarkdownDescription: "Title shown for the tag, e.g. `Runbooks`",
+				Type:                types.StringType,
+				Required:            true,
+			},
+		},
+	}, nil
+}
+
+func (t tagResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return tagResource{
+		provider: provider,
+	}, diags
+}
+
+type tagResourceData struct {
+	Id    types.String `tfsdk:"id"`
+	Tag   types.String `tfsdk:"tag"`
+	Title types.String `tfsdk:"title"`
+}
+
+func newTagResourceData(tag wikijs.PageTag) tagResourceData {
+	return tagResourceData{
+		Id:    types.String{Value: strconv.FormatInt(tag.Id, 10)},
+		Tag:   types.String{Value: tag.Tag},
+		Title: types.String{Value: tag.Title},
+	}
+}
+
+// findTag returns the tag for which match returns true, or nil if there is
+// none.
+func findTag(tags []wikijs.PageTag, match func(wikijs.PageTag) bool) *wikijs.PageTag {
+	for i := range tags {
+		if match(tags[i]) {
+			return &tags[i]
+		}
+	}
+	return nil
+}
+
+type tagResource struct {
+	provider provider
+}
+
+func (r tagResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data tagResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := r.provider.client.GetPageTags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tags, got error: %s", err))
+		return
+	}
+	tag := findTag(tags, func(tag wikijs.PageTag) bool { return tag.Tag == data.Tag.Value })
+	if tag == nil {
+		resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName("tag"), "Unknown Tag",
+			fmt.Sprintf("Tag %s does not exist, tags are created by adding them to a page", data.Tag.Value))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, tag.Id, data, &resp.State)...)
+}
+
+func (r tagResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data tagResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Tag id must be a number, got: %s", data.Id.Value))
+		return
+	}
+
+	tags, err := r.provider.client.GetPageTags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tags, got error: %s", err))
+		return
+	}
+	tag := findTag(tags, func(tag wikijs.PageTag) bool { return tag.Id == id })
+	if tag == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = newTagResourceData(*tag)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r tagResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data, state tagResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Tag id must be a number, got: %s", state.Id.Value))
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, id, data, &resp.State)...)
+}
+
+func (r tagResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data tagResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.Id.Value, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Id", fmt.Sprintf("Tag id must be a number, got: %s", data.Id.Value))
+		return
+	}
+
+	// Deleting a tag removes it from all pages, so tags in use are kept.
+	pages, err := r.provider.client.GetPages([]string{data.Tag.Value})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pages, got error: %s", err))
+		return
+	}
+	if len(pages) > 0 {
+		err = r.provider.client.UpdatePageTag(id, data.Tag.Value, data.Tag.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset tag title, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.AddWarning("Tag In Use",
+			fmt.Sprintf("Tag %s is used by %d pages, so it was kept and its title reset", data.Tag.Value, len(pages)))
+	} else {
+		err = r.provider.client.DeletePageTag(id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r tagResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply sets the title of the tag with id and reads it back.
+func (r tagResource) apply(ctx context.Context, id int64, data tagResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.provider.client.UpdatePageTag(id, data.Tag.Value, data.Title.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update tag, got error: %s", err))
+		return diags
+	}
+
+	tags, err := r.provider.client.GetPageTags()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read tags, got error: %s", err))
+		return diags
+	}
+	tag := findTag(tags, func(tag wikijs.PageTag) bool { return tag.Id == id })
+	if tag == nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find tag %s after updating it", data.Tag.Value))
+		return diags
+	}
+
+	data = newTagResourceData(*tag)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTagsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "wikijs_tags" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.wikijs_tags.test", "id", "tags"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagResource(t *testing.T) {
+	if testServer == nil {
+		t.Skip("tags are created with pages, which are seeded in the fake Wiki.js server")
+	}
+	testServer.AddPage("ops/failover", "Failover", "ops-runbook", "ops-database")
+	testServer.AddPage("ops/restore", "Restore", "ops-runbook")
+
+	tags := newDataSourceHarness(t, "wikijs_tags")
+	if diags := tags.readDataSource(map[string]interface{}{"query": "OPS-"}); hasError(diags) {
+		t.Fatalf("read tags: %v", diags)
+	}
+	found := []interface{}{}
+	for _, tag := range tags.attributes()["tags"].([]interface{}) {
+		tag := tag.(map[string]interface{})
+		found = append(found, tag["tag"])
+		if _, ok := tag["id"].(*big.Float); !ok || tag["title"] != tag["tag"] {
+			t.Errorf("unexpected tag %v", tag)
+		}
+	}
+	if len(found) != 2 || found[0] != "ops-database" || found[1] != "ops-runbook" {
+		t.Errorf("tags = %v, want [ops-database ops-runbook]", found)
+	}
+
+	h := newResourceHarness(t, "wikijs_tag")
+	config := map[string]interface{}{"tag": "ops-runbook", "title": "Runbooks"}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if h.attributes()["title"] != "Runbooks" {
+		t.Errorf("title = %v, want Runbooks", h.attributes()["title"])
+	}
+
+	config["title"] = "Operations Runbooks"
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	tagsByName := pageTagTitles(t)
+	if tagsByName["ops-runbook"] != "Operations Runbooks" {
+		t.Errorf("title not updated, got %v", tagsByName["ops-runbook"])
+	}
+
+	imported := newResourceHarness(t, "wikijs_tag")
+	if diags := imported.importState(h.attributes()["id"].(string)); hasError(diags) {
+		t.Fatalf("import: %v", diags)
+	}
+	if imported.attributes()["tag"] != "ops-runbook" || imported.attributes()["title"] != "Operations Runbooks" {
+		t.Errorf("unexpected imported attributes %v", imported.attributes())
+	}
+
+	if diags := newResourceHarness(t, "wikijs_tag").apply(map[string]interface{}{"tag": "ops-missing", "title": "Missing"}); !hasError(diags) {
+		t.Errorf("expected unknown tag to be invalid")
+	}
+
+	// Tags in use are kept with their title reset.
+	diags := h.destroy()
+	if hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	if warnings := diagnosticSummaries(diags, tfprotov6.DiagnosticSeverityWarning); len(warnings) != 1 || warnings[0] != "Tag In Use" {
+		t.Errorf("expected Tag In Use warning, got %v", warnings)
+	}
+	if title, ok := pageTagTitles(t)["ops-runbook"]; !ok || title != "ops-runbook" {
+		t.Errorf("expected tag to be kept with its title reset, got %q", title)
+	}
+
+	// Unused tags are deleted, Wiki.js keeps the tags of deleted pages.
+	testServer.AddPage("ops/archive", "Archive", "ops-archive")
+	testServer.DeletePage("ops/archive")
+	unused := newResourceHarness(t, "wikijs_tag")
+	if diags := unused.apply(map[string]interface{}{"tag": "ops-archive", "title": "Archive"}); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if diags := unused.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+	if _, ok := pageTagTitles(t)["ops-archive"]; ok {
+		t.Errorf("expected unused tag to be deleted")
+	}
+}
+
+func pageTagTitles(t *testing.T) map[string]string {
+	tags, err := wikijsClient.GetPageTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	titles := map[string]string{}
+	for _, tag := range tags {
+		titles[tag.Tag] = tag.Title
+	}
+	return titles
+}
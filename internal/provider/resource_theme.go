@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// themeId is the id of the singleton theme configuration.
+const themeId = "theme"
+
+type themeResourceType struct{}
+
+func (t themeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	iconset := settingAttribute("Icon set, one of `mdi`, `fa` or `fa4`", types.StringType)
+	iconset.Validators = []tfsdk.AttributeValidator{stringOneOf{values: []string{"mdi", "fa", "fa4"}}}
+
+	tocPosition := settingAttribute("Position of the table of contents, one of `left`, `right` or `hidden`", types.StringType)
+	tocPosition.Validators = []tfsdk.AttributeValidator{stringOneOf{values: []string{"left", "right", "hidden"}}}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Theme settings and injected code. Only configured settings are managed, all others are left untouched. " +
+			"Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"theme":        settingAttribute("Theme, e.g. `default`", types.StringType),
+			"iconset":      iconset,
+			"dark_mode":    settingAttribute("Use the dark mode of the theme", types.BoolType),
+			"toc_position": tocPosition,
+			"inject_css":   settingAttribute("CSS injected into all pages. Conflicts with `inject_css_file`", types.StringType),
+			"inject_css_file": {
+				MarkdownDescription: "Path of a file with CSS injected into all pages. Conflicts with `inject_css`",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"inject_css_minified": {
+				MarkdownDescription: "Injected CSS as minified and stored by Wiki.js, used to detect changes made outside of Terraform",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"inject_head": settingAttribute("HTML injected at the end of the `head` element. Conflicts with `inject_head_file`", types.StringType),
+			"inject_head_file": {
+				MarkdownDescription: "Path of a file with HTML injected at the end of the `head` element. Conflicts with `inject_head`",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"inject_body": settingAttribute("HTML injected at the end of the `body` element. Conflicts with `inject_body_file`", types.StringType),
+			"inject_body_file": {
+				MarkdownDescription: "Path of a file with HTML injected at the end of the `body` element. Conflicts with `inject_body`",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+		},
+	}, nil
+}
+
+func (t themeResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return themeResource{
+		provider: provider,
+	}, diags
+}
+
+type themeResourceData struct {
+	Id                types.String `tfsdk:"id"`
+	Theme             types.String `tfsdk:"theme"`
+	Iconset           types.String `tfsdk:"iconset"`
+	DarkMode          types.Bool   `tfsdk:"dark_mode"`
+	TocPosition       types.String `tfsdk:"toc_position"`
+	InjectCSS         types.String `tfsdk:"inject_css"`
+	InjectCSSFile     types.String `tfsdk:"inject_css_file"`
+	InjectCSSMinified types.String `tfsdk:"inject_css_minified"`
+	InjectHead        types.String `tfsdk:"inject_head"`
+	InjectHeadFile    types.String `tfsdk:"inject_head_file"`
+	InjectBody        types.String `tfsdk:"inject_body"`
+	InjectBodyFile    types.String `tfsdk:"inject_body_file"`
+}
+
+// themingConfig returns config with the known settings of data applied.
+// Wiki.js replaces all theme settings at once, so unconfigured settings keep
+// their current value.
+func (data themeResourceData) themingConfig(config wikijs.ThemingConfig) wikijs.ThemingConfig {
+	if value := stringPointer(data.Theme); value != nil {
+		config.Theme = *value
+	}
+	if value := stringPointer(data.Iconset); value != nil {
+		config.Iconset = *value
+	}
+	if value := boolPointer(data.DarkMode); value != nil {
+		config.DarkMode = *value
+	}
+	if value := stringPointer(data.TocPosition); value != nil {
+		config.TocPosition = *value
+	}
+	if value := stringPointer(data.InjectCSS); value != nil {
+		config.InjectCSS = *value
+	}
+	if value := stringPointer(data.InjectHead); value != nil {
+		config.InjectHead = *value
+	}
+	if value := stringPointer(data.InjectBody); value != nil {
+		config.InjectBody = *value
+	}
+	return config
+}
+
+// newThemeResourceData returns the settings of config. The injected CSS of
+// prior is kept as long as Wiki.js still stores the same minified CSS, as
+// the minified CSS differs from what was configured.
+func newThemeResourceData(config *wikijs.ThemingConfig, prior themeResourceData) themeResourceData {
+	data := themeResourceData{
+		Id:                types.String{Value: themeId},
+		Theme:             types.String{Value: config.Theme},
+		Iconset:           types.String{Value: config.Iconset},
+		DarkMode:          types.Bool{Value: config.DarkMode},
+		TocPosition:       types.String{Value: config.TocPosition},
+		InjectCSS:         types.String{Value: config.InjectCSS},
+		InjectCSSFile:     prior.InjectCSSFile,
+		InjectCSSMinified: types.String{Value: config.InjectCSS},
+		InjectHead:        types.String{Value: config.InjectHead},
+		InjectHeadFile:    prior.InjectHeadFile,
+		InjectBody:        types.String{Value: config.InjectBody},
+		InjectBodyFile:    prior.InjectBodyFile,
+	}
+	if !prior.InjectCSS.Null && !prior.InjectCSS.Unknown &&
+		!prior.InjectCSSMinified.Null && !prior.InjectCSSMinified.Unknown &&
+		prior.InjectCSSMinified.Value == config.InjectCSS {
+		data.InjectCSS = prior.InjectCSS
+	}
+	return data
+}
+
+type themeResource struct {
+	provider provider
+}
+
+func (r themeResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data themeResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conflicts := map[string]bool{
+		"inject_css":  !data.InjectCSS.Null && !data.InjectCSSFile.Null,
+		"inject_head": !data.InjectHead.Null && !data.InjectHeadFile.Null,
+		"inject_body": !data.InjectBody.Null && !data.InjectBodyFile.Null,
+	}
+	for attribute, conflict := range conflicts {
+		if conflict {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName(attribute+"_file"), "Conflicting Attributes",
+				fmt.Sprintf("Only one of %s and %s_file can be configured.", attribute, attribute))
+		}
+	}
+}
+
+// ModifyPlan plans the content of injected code files, so that changing a
+// file or the code in Wiki.js shows up as a difference.
+func (r themeResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data themeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	var state themeResourceData
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := []struct {
+		attribute string
+		file      types.String
+		content   *types.String
+	}{
+		{"inject_css_file", data.InjectCSSFile, &data.InjectCSS},
+		{"inject_head_file", data.InjectHeadFile, &data.InjectHead},
+		{"inject_body_file", data.InjectBodyFile, &data.InjectBody},
+	}
+	for _, file := range files {
+		if file.file.Null || file.file.Unknown {
+			continue
+		}
+		content, err := os.ReadFile(file.file.Value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(tftypes.NewAttributePath().WithAttributeName(file.attribute), "Unable to Read File", err.Error())
+			continue
+		}
+		*file.content = types.String{Value: string(content)}
+	}
+
+	// The minified CSS is only known after Wiki.js stored changed CSS.
+	if req.State.Raw.IsNull() || data.InjectCSS.Unknown || data.InjectCSS.Value != state.InjectCSS.Value {
+		data.InjectCSSMinified = types.String{Unknown: true}
+	} else {
+		data.InjectCSSMinified = state.InjectCSSMinified
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r themeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data themeResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r themeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data themeResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	themingConfig, err := r.provider.client.GetThemingConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read theme, got error: %s", err))
+		return
+	}
+
+	data = newThemeResourceData(themingConfig, data)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r themeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data themeResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, &resp.State)...)
+}
+
+func (r themeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The theme cannot be deleted, so the settings are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r themeResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// apply merges the planned settings into the current ones, sets them and
+// stores all settings in state.
+func (r themeResource) apply(ctx context.Context, data themeResourceData, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	themingConfig, err := r.provider.client.GetThemingConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read theme, got error: %s", err))
+		return diags
+	}
+
+	err = r.provider.client.SetThemingConfig(data.themingConfig(*themingConfig))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update theme, got error: %s", err))
+		return diags
+	}
+
+	themingConfig, err = r.provider.client.GetThemingConfig()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read theme, got error: %s", err))
+		return diags
+	}
+
+	// The planned CSS is what was set, however Wiki.js minified it.
+	data.InjectCSSMinified = types.String{Value: themingConfig.InjectCSS}
+	data = newThemeResourceData(themingConfig, data)
+	diags.Append(state.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccThemeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccThemeResourceConfig("red"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_theme.test", "id", "theme"),
+					resource.TestCheckResourceAttr("wikijs_theme.test", "dark_mode", "true"),
+					resource.TestCheckResourceAttr("wikijs_theme.test", "inject_css", ".v-main {\n  color: red;\n}\n"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccThemeResourceConfig("blue"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wikijs_theme.test", "inject_css", ".v-main {\n  color: blue;\n}\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccThemeResourceConfig(color string) string {
+	return `
+resource "wikijs_theme" "test" {
+	dark_mode    = true
+	toc_position = "right"
+	inject_css   = <<-EOT
+		.v-main {
+		  color: ` + color + `;
+		}
+	EOT
+}
+`
+}
+
+func TestThemeResource(t *testing.T) {
+	h := newResourceHarness(t, "wikijs_theme")
+
+	cssFile := filepath.Join(t.TempDir(), "theme.css")
+	css := "/* brand colors */\n.v-main {\n  color: red;\n}\n"
+	if err := os.WriteFile(cssFile, []byte(css), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config := map[string]interface{}{
+		"dark_mode":       true,
+		"inject_css_file": cssFile,
+		"inject_head":     `<meta name="robots" content="noarchive">`,
+	}
+
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	attributes := h.attributes()
+	if attributes["inject_css"] != css || attributes["inject_css_minified"] != ".v-main{color:red}" {
+		t.Errorf("unexpected state after create: %v", attributes)
+	}
+	themingConfig, err := wikijsClient.GetThemingConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !themingConfig.DarkMode || themingConfig.Theme != "default" {
+		t.Errorf("unexpected theme after create: %+v", themingConfig)
+	}
+
+	// The minified CSS is not a difference.
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	plan := h.planOnly(config)
+	if !reflect.DeepEqual(h.plannedAttributes(plan), h.attributes()) {
+		t.Errorf("expected empty plan, got %v", h.plannedAttributes(plan))
+	}
+
+	// CSS changed in Wiki.js is detected and planned to be reverted.
+	themingConfig.InjectCSS = ".v-main{color:green}"
+	if err := wikijsClient.SetThemingConfig(*themingConfig); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.read(); hasError(diags) {
+		t.Fatalf("read: %v", diags)
+	}
+	if h.attributes()["inject_css"] != ".v-main{color:green}" {
+		t.Errorf("drift not detected: %v", h.attributes()["inject_css"])
+	}
+	plan = h.planOnly(config)
+	if planned := h.plannedAttributes(plan); planned["inject_css"] != css || planned["inject_css_minified"] != nil {
+		t.Errorf("unexpected plan %v", planned)
+	}
+
+	// Changing the file is a difference.
+	css = ".v-main { color: blue; }"
+	if err := os.WriteFile(cssFile, []byte(css), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("update: %v", diags)
+	}
+	themingConfig, err = wikijsClient.GetThemingConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if themingConfig.InjectCSS != ".v-main{color:blue}" || themingConfig.InjectHead != config["inject_head"] {
+		t.Errorf("unexpected theme after update: %+v", themingConfig)
+	}
+
+	invalid := []map[string]interface{}{
+		{"inject_css": "a{}", "inject_css_file": cssFile},
+		{"toc_position": "top"},
+		{"inject_body_file": filepath.Join(t.TempDir(), "missing.html")},
+	}
+	for _, config := range invalid {
+		if diags := h.apply(config); !hasError(diags) {
+			t.Errorf("expected %v to be invalid", config)
+		}
+	}
+
+	if diags := h.destroy(); hasError(diags) {
+		t.Fatalf("destroy: %v", diags)
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// int64AtLeast validates that an integer attribute is at least min.
+type int64AtLeast struct {
+	min int64
+}
+
+func (v int64AtLeast) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+func (v int64AtLeast) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64AtLeast) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.Int64)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+	if value.Value < v.min {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s, got: %d", v.Description(ctx), value.Value))
+	}
+}
+
+// cspDirectiveName matches the name of a Content-Security-Policy directive.
+var cspDirectiveName = regexp.MustCompile(`^[a-z]+(-[a-z]+)*$`)
+
+// cspDirectives validates a list of Content-Security-Policy directives
+// separated by semicolons, e.g. `default-src 'self'; img-src *`.
+type cspDirectives struct{}
+
+func (v cspDirectives) Description(ctx context.Context) string {
+	return "value must be Content-Security-Policy directives separated by semicolons"
+}
+
+func (v cspDirectives) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cspDirectives) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+	for _, directive := range strings.Split(value.Value, ";") {
+		fields := strings.Fields(directive)
+		if len(fields) == 0 {
+			continue
+		}
+		if !cspDirectiveName.MatchString(fields[0]) {
+			resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s, got invalid directive name: %q", v.Description(ctx), fields[0]))
+		}
+	}
+}
+
+// stringOneOf validates that a string attribute is one of values.
+type stringOneOf struct {
+	values []string
+}
+
+func (v stringOneOf) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOf) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: `%s`", strings.Join(v.values, "`, `"))
+}
+
+func (v stringOneOf) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+	for _, allowed := range v.values {
+		if value.Value == allowed {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s, got: %q", v.Description(ctx), value.Value))
+}
+
+// stringMatches validates that a string attribute matches pattern.
+type stringMatches struct {
+	pattern     *regexp.Regexp
+	description string
+}
+
+func (v stringMatches) Description(ctx context.Context) string {
+	return "value must be " + v.description
+}
+
+func (v stringMatches) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringMatches) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.String)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+	if !v.pattern.MatchString(value.Value) {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s, got: %q", v.Description(ctx), value.Value))
+	}
+}
+
+// stringListMatches validates that every element of a string list attribute
+// matches pattern.
+type stringListMatches struct {
+	pattern     *regexp.Regexp
+	description string
+}
+
+func (v stringListMatches) Description(ctx context.Context) string {
+	return "elements must be " + v.description
+}
+
+func (v stringListMatches) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringListMatches) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := req.AttributeConfig.(types.List)
+	if !ok || value.Null || value.Unknown {
+		return
+	}
+	for i, element := range value.Elems {
+		element, ok := element.(types.String)
+		if !ok || element.Null || element.Unknown {
+			continue
+		}
+		if !v.pattern.MatchString(element.Value) {
+			resp.Diagnostics.AddAttributeError(req.AttributePath.WithElementKeyInt(i), "Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s, got: %q", v.Description(ctx), element.Value))
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// settingAttribute describes a setting of which the resource only owns the
+// value if it is configured. Unconfigured settings are read from wikijs and
+// left untouched.
+func settingAttribute(description string, attributeType attr.Type) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: description,
+		Type:                attributeType,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.UseStateForUnknown(),
+		},
+	}
+}
+
+// stringPointer returns the configured value, or nil if it is not set.
+func stringPointer(value types.String) *string {
+	if value.Null || value.Unknown {
+		return nil
+	}
+	return &value.Value
+}
+
+// boolPointer returns the configured value, or nil if it is not set.
+func boolPointer(value types.Bool) *bool {
+	if value.Null || value.Unknown {
+		return nil
+	}
+	return &value.Value
+}
+
+// int64Pointer returns the configured value, or nil if it is not set.
+func int64Pointer(value types.Int64) *int64 {
+	if value.Null || value.Unknown {
+		return nil
+	}
+	return &value.Value
+}
+
+// stringListPointer returns the configured list, or nil if it is not set.
+func stringListPointer(ctx context.Context, value types.List) (*[]string, diag.Diagnostics) {
+	if value.Null || value.Unknown {
+		return nil, nil
+	}
+	values := []string{}
+	diags := value.ElementsAs(ctx, &values, false)
+	return &values, diags
+}
+
+func stringList(values []string) types.List {
+	list := types.List{
+		ElemType: types.StringType,
+		Elems:    []attr.Value{},
+	}
+	for _, value := range values {
+		list.Elems = append(list.Elems, types.String{Value: value})
+	}
+	return list
+}
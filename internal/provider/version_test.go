@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs"
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+)
+
+func TestUnsupportedServerVersion(t *testing.T) {
+	server := wikijstest.NewServer()
+	defer server.Close()
+	server.SetVersion("2.4.36")
+	for _, field := range []string{"comments.providers", "comments.updateProviders", "navigation.config", "navigation.updateConfig"} {
+		server.RemoveField(field)
+	}
+	client, err := wikijs.NewWikijsClient(server.URL, wikijstest.DefaultAdminEmail, wikijstest.DefaultAdminPassword, true, 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultProvider := testAccProvider
+	defer func() { testAccProvider = defaultProvider }()
+	testAccProvider = New("test", client)()
+
+	diags := newResourceHarness(t, "wikijs_comments_provider").apply(map[string]interface{}{"key": "default"})
+	if !hasError(diags) || !strings.Contains(diags[0].Detail, "not available in Wiki.js 2.4.36") {
+		t.Errorf("expected comments to be unsupported, got %v", diags)
+	}
+
+	h := newResourceHarness(t, "wikijs_navigation")
+	config := map[string]interface{}{
+		"locale": "en",
+		"mode":   "STATIC",
+		"items":  []map[string]interface{}{{"kind": "header", "label": "Documentation"}},
+	}
+	diags = h.apply(config)
+	if !hasError(diags) || !strings.Contains(diags[0].Detail, "GraphQL field navigation.updateConfig is not available in Wiki.js 2.4.36") {
+		t.Errorf("expected the navigation mode to be unsupported, got %v", diags)
+	}
+
+	// The navigation itself works without the mode.
+	h = newResourceHarness(t, "wikijs_navigation")
+	delete(config, "mode")
+	if diags := h.apply(config); hasError(diags) {
+		t.Fatalf("create: %v", diags)
+	}
+	if mode := h.attributes()["mode"]; mode != nil {
+		t.Errorf("mode = %v, want null", mode)
+	}
+}
@@ -0,0 +1,82 @@
+// Package secrets decides which values in Wiki.js GraphQL requests and
+// responses are secret. The client log and the recorded test fixtures share
+// these rules, so a secret hidden in one is hidden in the other.
+package secrets
+
+import (
+	"regexp"
+	"strings"
+)
+
+// keySeparators matches separators in keys like private_key.
+var keySeparators = regexp.MustCompile(`[^a-z0-9]`)
+
+// IsSensitiveKey reports whether values stored under key hold passwords,
+// JWTs, API keys or other secrets.
+func IsSensitiveKey(key string) bool {
+	key = keySeparators.ReplaceAllString(strings.ToLower(key), "")
+	if key == "pass" {
+		return true
+	}
+	for _, sensitive := range []string{"password", "secret", "token", "jwt", "apikey", "privatekey", "accountkey", "adminkey"} {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// Mask replaces the secrets in a decoded JSON value in place and returns the
+// value. Plain fields and key/value pairs with a sensitive key are masked, as
+// are all values in the config of modules, which hold secrets under keys like
+// accountKey or credentials. Objects under a sensitive key, e.g. the result of createApiKey, are
+// searched for secrets rather than masked as a whole. replace returns the
+// masked form of a secret, nil secrets are kept.
+func Mask(value interface{}, replace func(secret interface{}) interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if key, ok := v["key"].(string); ok && IsSensitiveKey(key) {
+			if secret, ok := v["value"]; ok && secret != nil {
+				v["value"] = replace(secret)
+			}
+		}
+		for key, child := range v {
+			if key == "config" {
+				if pairs, ok := child.([]interface{}); ok {
+					maskConfig(pairs, replace)
+					continue
+				}
+			}
+			if IsSensitiveKey(key) && isScalar(child) {
+				v[key] = replace(child)
+				continue
+			}
+			v[key] = Mask(child, replace)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = Mask(child, replace)
+		}
+	}
+	return value
+}
+
+// maskConfig masks the values of module config key/value pairs.
+func maskConfig(pairs []interface{}, replace func(secret interface{}) interface{}) {
+	for _, pair := range pairs {
+		if pair, ok := pair.(map[string]interface{}); ok {
+			if secret, ok := pair["value"]; ok && secret != nil {
+				pair["value"] = replace(secret)
+			}
+		}
+	}
+}
+
+// isScalar reports whether value is a non-nil JSON string, number or boolean.
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case nil, map[string]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSensitiveKey(t *testing.T) {
+	for _, key := range []string{"password", "adminPasswordConfirm", "pass", "jwt", "continuationToken", "clientSecret", "apiKey", "dkimPrivateKey", "adminKey", "accountKey", "private_key", "PRIVATE-KEY"} {
+		assert.True(t, IsSensitiveKey(key), key)
+	}
+	for _, key := range []string{"username", "key", "host", "port", "passive"} {
+		assert.False(t, IsSensitiveKey(key), key)
+	}
+}
+
+func TestMask(t *testing.T) {
+	redact := func(interface{}) interface{} { return "***" }
+
+	masked := Mask(map[string]interface{}{
+		"username": "admin@wiki.example.local",
+		"password": "wikijsrocks",
+		"jwt":      nil,
+		"pairs": []interface{}{
+			map[string]interface{}{"key": "clientId", "value": "wiki"},
+			map[string]interface{}{"key": "clientSecret", "value": "secret"},
+		},
+		"config": []interface{}{
+			map[string]interface{}{"key": "host", "value": `{"v":"wiki"}`},
+			map[string]interface{}{"key": "credentials", "value": `{"v":"secret"}`},
+		},
+	}, redact)
+	assert.Equal(t, map[string]interface{}{
+		"username": "admin@wiki.example.local",
+		"password": "***",
+		"jwt":      nil,
+		"pairs": []interface{}{
+			map[string]interface{}{"key": "clientId", "value": "wiki"},
+			map[string]interface{}{"key": "clientSecret", "value": "***"},
+		},
+		"config": []interface{}{
+			map[string]interface{}{"key": "host", "value": "***"},
+			map[string]interface{}{"key": "credentials", "value": "***"},
+		},
+	}, masked)
+}
@@ -0,0 +1,133 @@
+package wikijs
+
+type AnalyticsProvider struct {
+	IsEnabled   bool           `json:"isEnabled"`
+	Key         string         `json:"key"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	IsAvailable bool           `json:"isAvailable"`
+	Logo        string         `json:"logo"`
+	Website     string         `json:"website"`
+	Config      []KeyValuePair `json:"config"`
+}
+
+type GetAnalyticsProviders struct {
+	Data struct {
+		Analytics struct {
+			Providers []AnalyticsProvider `json:"providers"`
+		} `json:"analytics"`
+	} `json:"data"`
+}
+
+// AnalyticsProviderInput holds the settings of an analytics provider to
+// update. The config values are encoded with EncodeModuleConfig.
+type AnalyticsProviderInput struct {
+	IsEnabled bool           `json:"isEnabled"`
+	Key       string         `json:"key"`
+	Config    []KeyValuePair `json:"config"`
+}
+
+type UpdateAnalyticsProvidersVariables struct {
+	Providers []AnalyticsProviderInput `json:"providers"`
+}
+
+type UpdateAnalyticsProvidersResult struct {
+	Data struct {
+		Analytics struct {
+			UpdateProviders struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateProviders"`
+		} `json:"analytics"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetAnalyticsProviders() ([]AnalyticsProvider, error) {
+
+	getAnalyticsProvidersData := GraphQl{
+		Query: `
+{
+	analytics {
+		providers {
+			isEnabled
+			key
+			title
+			description
+			isAvailable
+			logo
+			website
+			config {
+				key
+				value
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getAnalyticsProviders GetAnalyticsProviders
+	err := wikijsClient.postGraphQl(getAnalyticsProvidersData, &getAnalyticsProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAnalyticsProviders.Data.Analytics.Providers, nil
+}
+
+// UpdateAnalyticsProviders updates the analytics providers. Wiki.js expects
+// the full list of providers, see AnalyticsProviderInputs.
+func (wikijsClient *WikijsClient) UpdateAnalyticsProviders(providers []AnalyticsProviderInput) error {
+
+	updateAnalyticsProvidersData := GraphQl{
+		Variables: UpdateAnalyticsProvidersVariables{Providers: providers},
+		Query: `
+mutation ($providers: [AnalyticsProviderInput]!) {
+	analytics {
+		updateProviders(providers: $providers) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateAnalyticsProvidersResult UpdateAnalyticsProvidersResult
+	err := wikijsClient.postGraphQl(updateAnalyticsProvidersData, &updateAnalyticsProvidersResult)
+	if err != nil {
+		return err
+	}
+
+	return updateAnalyticsProvidersResult.Data.Analytics.UpdateProviders.ResponseResult.Err()
+}
+
+// AnalyticsProviderInputs returns the providers as input for
+// UpdateAnalyticsProviders, so that a single provider can be changed while
+// keeping the others.
+func AnalyticsProviderInputs(providers []AnalyticsProvider) ([]AnalyticsProviderInput, error) {
+	inputs := []AnalyticsProviderInput{}
+	for _, provider := range providers {
+		properties, err := DecodeModuleConfig(provider.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := EncodeModuleConfig(ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, AnalyticsProviderInput{
+			IsEnabled: provider.IsEnabled,
+			Key:       provider.Key,
+			Config:    config,
+		})
+	}
+	return inputs, nil
+}
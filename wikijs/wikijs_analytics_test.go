@@ -0,0 +1,48 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestAnalyticsProviders() {
+
+	providers, err := suite.Client.GetAnalyticsProviders()
+	assert.Nil(suite.T(), err)
+	original, err := AnalyticsProviderInputs(providers)
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateAnalyticsProviders(original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	inputs, err := AnalyticsProviderInputs(providers)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		if inputs[i].Key != "plausible" {
+			continue
+		}
+		inputs[i].IsEnabled = true
+		inputs[i].Config, err = EncodeModuleConfig(map[string]interface{}{
+			"domain":         "wiki.example.com",
+			"plausibleJsSrc": "https://plausible.example.com/js/plausible.js",
+		})
+		assert.Nil(suite.T(), err)
+	}
+	err = suite.Client.UpdateAnalyticsProviders(inputs)
+	assert.Nil(suite.T(), err)
+
+	providers, err = suite.Client.GetAnalyticsProviders()
+	assert.Nil(suite.T(), err)
+	found := false
+	for _, provider := range providers {
+		if provider.Key != "plausible" {
+			continue
+		}
+		found = true
+		assert.True(suite.T(), provider.IsEnabled)
+		properties, err := DecodeModuleConfig(provider.Config)
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "wiki.example.com", ModuleConfigValues(properties)["domain"])
+	}
+	assert.True(suite.T(), found)
+}
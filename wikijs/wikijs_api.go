@@ -2,7 +2,9 @@ package wikijs
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 )
 
@@ -63,6 +65,30 @@ type ResponseResultStruct struct {
 	Typename  string `json:"__typename"`
 }
 
+type GraphQlError struct {
+	Message string `json:"message"`
+}
+
+type GraphQlErrors struct {
+	Errors []GraphQlError `json:"errors"`
+}
+
+// Err returns the first GraphQL error of a response, if any.
+func (graphQlErrors GraphQlErrors) Err() error {
+	if len(graphQlErrors.Errors) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", graphQlErrors.Errors[0].Message)
+}
+
+// Err returns an error if a mutation did not succeed.
+func (responseResult ResponseResultStruct) Err() error {
+	if responseResult.Succeeded {
+		return nil
+	}
+	return fmt.Errorf("%s (%s)", responseResult.Message, responseResult.Slug)
+}
+
 type LoginCredentials struct {
 	Data struct {
 		Authentication struct {
@@ -173,6 +199,35 @@ type ActiveAuthenticationStrategies struct {
 	} `json:"data"`
 }
 
+// postGraphQl sends a GraphQL document and unmarshals the response into
+// result. GraphQL errors in the response are returned as error, including
+// those of documents failing validation, which Wiki.js answers with status
+// 400.
+func (wikijsClient *WikijsClient) postGraphQl(data GraphQl, result interface{}) error {
+	response, _, err := wikijsClient.post("/graphql", data)
+	var apiError *ApiError
+	if errors.As(err, &apiError) && apiError.Code == http.StatusBadRequest {
+		var graphQlErrors GraphQlErrors
+		if json.Unmarshal(apiError.Body, &graphQlErrors) == nil && graphQlErrors.Err() != nil {
+			return wikijsClient.unsupportedFieldError(graphQlErrors.Err())
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	var graphQlErrors GraphQlErrors
+	err = json.Unmarshal(response, &graphQlErrors)
+	if err != nil {
+		return err
+	}
+	if err = graphQlErrors.Err(); err != nil {
+		return wikijsClient.unsupportedFieldError(err)
+	}
+
+	return json.Unmarshal(response, result)
+}
+
 func (wikijsClient *WikijsClient) apiEnabled() (bool, error) {
 
 	getApiData := GraphQl{
@@ -188,7 +243,7 @@ func (wikijsClient *WikijsClient) apiEnabled() (bool, error) {
 	if err != nil {
 		// if api is disabled then we wont be able to get a result :)
 		// Check if site is up. IF so then return no error and false for apiEnabled?
-		_, err = wikijsClient.retryablehttpClient.Get(wikijsClient.host + "/")
+		_, err = wikijsClient.getRoot()
 		if err != nil {
 			return false, nil
 		}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/thanhpk/randstr"
@@ -15,6 +16,7 @@ type WikijsApiTestSuite struct {
 	Username string
 	Password string
 	Client   *WikijsClient
+	Server   *wikijstest.Server
 }
 
 func TestWikijsApiTestSuite(t *testing.T) {
@@ -25,6 +27,12 @@ func (suite *WikijsApiTestSuite) SetupSuite() {
 	suite.Host = os.Getenv("WIKIJS_HOST")
 	suite.Username = os.Getenv("WIKIJS_USERNAME")
 	suite.Password = os.Getenv("WIKIJS_PASSWORD")
+	if suite.Host == "" {
+		suite.Server = wikijstest.NewServer()
+		suite.Host = suite.Server.URL
+		suite.Username = wikijstest.DefaultAdminEmail
+		suite.Password = wikijstest.DefaultAdminPassword
+	}
 	suite.Client, _ = NewWikijsClient(suite.Host, suite.Username, suite.Password, true, 10, "")
 	if assert.NotNil(suite.T(), suite.Client) {
 		setupDone, err := suite.Client.SetupDone()
@@ -33,6 +41,12 @@ func (suite *WikijsApiTestSuite) SetupSuite() {
 	}
 }
 
+func (suite *WikijsApiTestSuite) TearDownSuite() {
+	if suite.Server != nil {
+		suite.Server.Close()
+	}
+}
+
 func (suite *WikijsApiTestSuite) TestSetApi() {
 
 	enabled, err := suite.Client.apiEnabled()
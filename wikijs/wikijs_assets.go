@@ -0,0 +1,314 @@
+package wikijs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// Kinds of assets to list with GetAssets.
+const (
+	AssetKindImage  = "IMAGE"
+	AssetKindBinary = "BINARY"
+	AssetKindAll    = "ALL"
+)
+
+// RootAssetFolderId is the id of the root folder of the asset manager.
+const RootAssetFolderId = 0
+
+type AssetFolder struct {
+	Id   int64  `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+type Asset struct {
+	Id        int64  `json:"id"`
+	Filename  string `json:"filename"`
+	Ext       string `json:"ext"`
+	Kind      string `json:"kind"`
+	Mime      string `json:"mime"`
+	FileSize  int64  `json:"fileSize"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type GetAssetFoldersVariables struct {
+	ParentFolderId int64 `json:"parentFolderId"`
+}
+
+type GetAssetFolders struct {
+	Data struct {
+		Assets struct {
+			Folders []AssetFolder `json:"folders"`
+		} `json:"assets"`
+	} `json:"data"`
+}
+
+type CreateAssetFolderVariables struct {
+	ParentFolderId int64  `json:"parentFolderId"`
+	Slug           string `json:"slug"`
+}
+
+type CreateAssetFolderResult struct {
+	Data struct {
+		Assets struct {
+			CreateFolder struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"createFolder"`
+		} `json:"assets"`
+	} `json:"data"`
+}
+
+type GetAssetsVariables struct {
+	FolderId int64  `json:"folderId"`
+	Kind     string `json:"kind"`
+}
+
+type GetAssets struct {
+	Data struct {
+		Assets struct {
+			List []Asset `json:"list"`
+		} `json:"assets"`
+	} `json:"data"`
+}
+
+type RenameAssetVariables struct {
+	Id       int64  `json:"id"`
+	Filename string `json:"filename"`
+}
+
+type RenameAssetResult struct {
+	Data struct {
+		Assets struct {
+			RenameAsset struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"renameAsset"`
+		} `json:"assets"`
+	} `json:"data"`
+}
+
+type DeleteAssetVariables struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteAssetResult struct {
+	Data struct {
+		Assets struct {
+			DeleteAsset struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"deleteAsset"`
+		} `json:"assets"`
+	} `json:"data"`
+}
+
+// GetAssetFolders returns the folders directly below the folder with
+// parentFolderId, see RootAssetFolderId.
+func (wikijsClient *WikijsClient) GetAssetFolders(parentFolderId int64) ([]AssetFolder, error) {
+
+	getAssetFoldersData := GraphQl{
+		Variables: GetAssetFoldersVariables{ParentFolderId: parentFolderId},
+		Query: `
+query ($parentFolderId: Int!) {
+	assets {
+		folders(parentFolderId: $parentFolderId) {
+			id
+			slug
+			name
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getAssetFolders GetAssetFolders
+	err := wikijsClient.postGraphQl(getAssetFoldersData, &getAssetFolders)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAssetFolders.Data.Assets.Folders, nil
+}
+
+// CreateAssetFolder creates a folder below the folder with parentFolderId.
+// Wiki.js names the folder after its slug and does not return it, use
+// GetAssetFolders to find it by slug.
+func (wikijsClient *WikijsClient) CreateAssetFolder(parentFolderId int64, slug string) error {
+
+	createAssetFolderData := GraphQl{
+		Variables: CreateAssetFolderVariables{
+			ParentFolderId: parentFolderId,
+			Slug:           slug,
+		},
+		Query: `
+mutation ($parentFolderId: Int!, $slug: String!) {
+	assets {
+		createFolder(parentFolderId: $parentFolderId, slug: $slug) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var createAssetFolderResult CreateAssetFolderResult
+	err := wikijsClient.postGraphQl(createAssetFolderData, &createAssetFolderResult)
+	if err != nil {
+		return err
+	}
+
+	return createAssetFolderResult.Data.Assets.CreateFolder.ResponseResult.Err()
+}
+
+// GetAssets returns the assets of kind in the folder with folderId.
+func (wikijsClient *WikijsClient) GetAssets(folderId int64, kind string) ([]Asset, error) {
+
+	getAssetsData := GraphQl{
+		Variables: GetAssetsVariables{FolderId: folderId, Kind: kind},
+		Query: `
+query ($folderId: Int!, $kind: AssetKind!) {
+	assets {
+		list(folderId: $folderId, kind: $kind) {
+			id
+			filename
+			ext
+			kind
+			mime
+			fileSize
+			createdAt
+			updatedAt
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getAssets GetAssets
+	err := wikijsClient.postGraphQl(getAssetsData, &getAssets)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAssets.Data.Assets.List, nil
+}
+
+// RenameAsset renames the asset within its folder. Wiki.js does not allow
+// changing the file extension.
+func (wikijsClient *WikijsClient) RenameAsset(id int64, filename string) error {
+
+	renameAssetData := GraphQl{
+		Variables: RenameAssetVariables{Id: id, Filename: filename},
+		Query: `
+mutation ($id: Int!, $filename: String!) {
+	assets {
+		renameAsset(id: $id, filename: $filename) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var renameAssetResult RenameAssetResult
+	err := wikijsClient.postGraphQl(renameAssetData, &renameAssetResult)
+	if err != nil {
+		return err
+	}
+
+	return renameAssetResult.Data.Assets.RenameAsset.ResponseResult.Err()
+}
+
+func (wikijsClient *WikijsClient) DeleteAsset(id int64) error {
+
+	deleteAssetData := GraphQl{
+		Variables: DeleteAssetVariables{Id: id},
+		Query: `
+mutation ($id: Int!) {
+	assets {
+		deleteAsset(id: $id) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var deleteAssetResult DeleteAssetResult
+	err := wikijsClient.postGraphQl(deleteAssetData, &deleteAssetResult)
+	if err != nil {
+		return err
+	}
+
+	return deleteAssetResult.Data.Assets.DeleteAsset.ResponseResult.Err()
+}
+
+// UploadAsset uploads content as filename into the folder with folderId. An
+// existing asset with the same filename is replaced, keeping its id. Wiki.js
+// lowercases filenames and replaces whitespace, commas, semicolons and hashes
+// with underscores.
+func (wikijsClient *WikijsClient) UploadAsset(folderId int64, filename string, content []byte) error {
+	return wikijsClient.uploadAsset(folderId, filename, int64(len(content)), func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	})
+}
+
+// UploadAssetFile uploads the local file source as filename like
+// UploadAsset. The file is streamed, so large files are not loaded into
+// memory.
+func (wikijsClient *WikijsClient) UploadAssetFile(folderId int64, filename, source string) error {
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+	return wikijsClient.uploadAsset(folderId, filename, info.Size(), func() (io.ReadCloser, error) {
+		return os.Open(source)
+	})
+}
+
+func (wikijsClient *WikijsClient) uploadAsset(folderId int64, filename string, size int64, open func() (io.ReadCloser, error)) error {
+	metadata, err := json.Marshal(map[string]int64{"folderId": folderId})
+	if err != nil {
+		return err
+	}
+
+	// Wiki.js expects the folder as first part, followed by the file, both
+	// named mediaUpload.
+	_, err = wikijsClient.postMultipart("/u",
+		[]multipartField{{name: "mediaUpload", value: string(metadata)}},
+		[]multipartFile{{fieldName: "mediaUpload", filename: filename, open: open}},
+		map[string]interface{}{
+			"filename":  filename,
+			"folder_id": folderId,
+			"size":      size,
+		})
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %v", filename, err)
+	}
+	return nil
+}
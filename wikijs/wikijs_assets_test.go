@@ -0,0 +1,148 @@
+package wikijs
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/thanhpk/randstr"
+)
+
+func (suite *WikijsApiTestSuite) TestAssets() {
+
+	// Folders cannot be deleted, so a new one is used for every run.
+	slug := "test-" + strings.ToLower(randstr.String(8))
+	err := suite.Client.CreateAssetFolder(RootAssetFolderId, slug)
+	assert.Nil(suite.T(), err)
+	err = suite.Client.CreateAssetFolder(RootAssetFolderId, slug)
+	assert.NotNil(suite.T(), err)
+
+	folders, err := suite.Client.GetAssetFolders(RootAssetFolderId)
+	assert.Nil(suite.T(), err)
+	var folder *AssetFolder
+	for i := range folders {
+		if folders[i].Slug == slug {
+			folder = &folders[i]
+		}
+	}
+	if !assert.NotNil(suite.T(), folder) {
+		return
+	}
+	assert.Equal(suite.T(), slug, folder.Name)
+
+	err = suite.Client.UploadAsset(folder.Id, "Runbook Diagram.png", []byte("\x89PNG first"))
+	assert.Nil(suite.T(), err)
+	err = suite.Client.UploadAsset(folder.Id, "notes.pdf", []byte("%PDF-1.4"))
+	assert.Nil(suite.T(), err)
+
+	images, err := suite.Client.GetAssets(folder.Id, AssetKindImage)
+	assert.Nil(suite.T(), err)
+	if !assert.Len(suite.T(), images, 1) {
+		return
+	}
+	image := images[0]
+	assert.Equal(suite.T(), "runbook_diagram.png", image.Filename)
+	assert.Equal(suite.T(), ".png", image.Ext)
+	assert.Equal(suite.T(), "image/png", image.Mime)
+	assert.Equal(suite.T(), int64(10), image.FileSize)
+
+	assets, err := suite.Client.GetAssets(folder.Id, AssetKindAll)
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), assets, 2)
+
+	// Uploading the same filename replaces the content and keeps the id.
+	err = suite.Client.UploadAsset(folder.Id, "runbook_diagram.png", []byte("\x89PNG second version"))
+	assert.Nil(suite.T(), err)
+	images, err = suite.Client.GetAssets(folder.Id, AssetKindImage)
+	assert.Nil(suite.T(), err)
+	if assert.Len(suite.T(), images, 1) {
+		assert.Equal(suite.T(), image.Id, images[0].Id)
+		assert.Equal(suite.T(), int64(19), images[0].FileSize)
+	}
+
+	err = suite.Client.RenameAsset(image.Id, "diagram.png")
+	assert.Nil(suite.T(), err)
+	err = suite.Client.RenameAsset(image.Id, "diagram.jpg")
+	assert.NotNil(suite.T(), err)
+	images, err = suite.Client.GetAssets(folder.Id, AssetKindImage)
+	assert.Nil(suite.T(), err)
+	if assert.Len(suite.T(), images, 1) {
+		assert.Equal(suite.T(), "diagram.png", images[0].Filename)
+	}
+
+	for _, asset := range assets {
+		err = suite.Client.DeleteAsset(asset.Id)
+		assert.Nil(suite.T(), err)
+	}
+	err = suite.Client.DeleteAsset(image.Id)
+	assert.NotNil(suite.T(), err)
+	assets, err = suite.Client.GetAssets(folder.Id, AssetKindAll)
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), assets)
+}
+
+// failingTransport answers the first requests to the paths in failures with
+// 503 after reading their body, so that retries have to send it again.
+type failingTransport struct {
+	failures map[string]int
+}
+
+func (t *failingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method == http.MethodPost && t.failures[request.URL.Path] > 0 {
+		t.failures[request.URL.Path]--
+		if request.Body != nil {
+			_, _ = io.Copy(ioutil.Discard, request.Body)
+			request.Body.Close()
+		}
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Status:     "503 Service Unavailable",
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+			Request:    request,
+		}, nil
+	}
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func TestUploadAssetFile(t *testing.T) {
+	server := wikijstest.NewServer()
+	defer server.Close()
+
+	transport := &failingTransport{failures: map[string]int{}}
+	client, err := NewWikijsClient(server.URL, wikijstest.DefaultAdminEmail, wikijstest.DefaultAdminPassword, true, 10, "",
+		WithTransport(transport),
+		WithRetry(2, time.Millisecond, 2*time.Millisecond),
+	)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	content := bytes.Repeat([]byte("0123456789abcdef"), 256*1024)
+	source := filepath.Join(t.TempDir(), "large.bin")
+	err = ioutil.WriteFile(source, content, 0600)
+	assert.Nil(t, err)
+
+	// Both GraphQL requests and uploads send their full body again on retry.
+	transport.failures["/graphql"] = 1
+	transport.failures["/u"] = 1
+	err = client.CreateAssetFolder(RootAssetFolderId, "uploads")
+	assert.Nil(t, err)
+	err = client.UploadAssetFile(1, "large.bin", source)
+	assert.Nil(t, err)
+
+	uploaded, ok := server.AssetContent(1, "large.bin")
+	assert.True(t, ok)
+	assert.Equal(t, len(content), len(uploaded))
+	assert.True(t, bytes.Equal(content, uploaded))
+
+	err = client.UploadAssetFile(1, "missing.bin", filepath.Join(t.TempDir(), "missing.bin"))
+	assert.NotNil(t, err)
+}
@@ -1,17 +1,16 @@
 package wikijs
 
 import (
-	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"log"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
@@ -23,6 +22,9 @@ import (
 type ApiError struct {
 	Code    int
 	Message string
+	// Body is the body of the response, e.g. the GraphQL errors of a
+	// document failing validation.
+	Body []byte `json:"-"`
 }
 
 func (e *ApiError) Error() string {
@@ -34,7 +36,10 @@ type WikijsClient struct {
 	clientCredentials   *ClientCredentials
 	retryablehttpClient *retryablehttp.Client
 	configured          bool
-	debug               bool
+	userAgent           string
+	logger              Logger
+	// version is the version of the server, nil if it could not be detected.
+	version *Version
 }
 
 type ClientCredentials struct {
@@ -45,10 +50,12 @@ type ClientCredentials struct {
 	ApiKeyName string
 }
 
-func wikiJsClient(host string, clientTimeout int64, caCert string) (*WikijsClient, error) {
+func wikiJsClient(host string, clientTimeout int64, caCert string, opts ...ClientOption) (*WikijsClient, error) {
 	clientCredentials := &ClientCredentials{}
 
-	retryablehttpClient, err := newHttpClient(clientTimeout, caCert)
+	options := newClientOptions(opts)
+
+	retryablehttpClient, err := newHttpClient(clientTimeout, caCert, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create http client: %v", err)
 	}
@@ -58,9 +65,11 @@ func wikiJsClient(host string, clientTimeout int64, caCert string) (*WikijsClien
 		clientCredentials:   clientCredentials,
 		retryablehttpClient: retryablehttpClient,
 		configured:          false,
+		userAgent:           options.userAgent,
+		logger:              options.logger,
 	}
 
-	response, err := wikijsClient.retryablehttpClient.Get(wikijsClient.host + "/")
+	response, err := wikijsClient.getRoot()
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to wikijs: %v", err)
 	}
@@ -72,8 +81,8 @@ func wikiJsClient(host string, clientTimeout int64, caCert string) (*WikijsClien
 	return &wikijsClient, nil
 }
 
-func NewWikijsClient(host, adminEmail, password string, initialSetup bool, clientTimeout int64, caCert string) (*WikijsClient, error) {
-	wikijsClient, err := wikiJsClient(host, clientTimeout, caCert)
+func NewWikijsClient(host, adminEmail, password string, initialSetup bool, clientTimeout int64, caCert string, opts ...ClientOption) (*WikijsClient, error) {
+	wikijsClient, err := wikiJsClient(host, clientTimeout, caCert, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,12 +113,7 @@ func NewWikijsClient(host, adminEmail, password string, initialSetup bool, clien
 	wikijsClient.clientCredentials.ApiToken = key
 	wikijsClient.clientCredentials.ApiKeyName = apiKeyName
 	wikijsClient.configured = true
-
-	if tfLog, ok := os.LookupEnv("TF_LOG"); ok {
-		if tfLog == "DEBUG" {
-			wikijsClient.debug = true
-		}
-	}
+	wikijsClient.detectVersion()
 
 	return wikijsClient, nil
 }
@@ -230,45 +234,140 @@ func (wikijsClient *WikijsClient) Cleanup() error {
 	return err
 }
 
-func newHttpClient(clientTimeout int64, caCert string) (*retryablehttp.Client, error) {
-	cookieJar, err := cookiejar.New(&cookiejar.Options{
-		PublicSuffixList: publicsuffix.List,
-	})
-	if err != nil {
-		return nil, err
+func newHttpClient(clientTimeout int64, caCert string, options *clientOptions) (*retryablehttp.Client, error) {
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = options.retryMax
+	retryClient.RetryWaitMin = options.retryWaitMin
+	retryClient.RetryWaitMax = options.retryWaitMax
+	retryClient.Logger = retryLogger{logger: options.logger}
+	retryClient.RequestLogHook = func(_ retryablehttp.Logger, request *http.Request, attempt int) {
+		if attempt > 0 {
+			options.logger.Warn("Retrying request", map[string]interface{}{
+				"method":        request.Method,
+				"path":          request.URL.Path,
+				"retry_attempt": attempt,
+			})
+		}
+	}
+
+	// TLS settings only apply to the transport built here, so they cannot be
+	// combined with a client or transport of the caller.
+	tlsConfigured := caCert != "" || options.clientCert != "" || options.clientKey != "" || options.insecureSkipVerify
+	if tlsConfigured && options.httpClient != nil {
+		return nil, fmt.Errorf("ca_cert, client certificates and insecure_skip_verify cannot be combined with WithHTTPClient, configure TLS on the http.Client instead")
+	}
+	if tlsConfigured && options.transport != nil {
+		return nil, fmt.Errorf("ca_cert, client certificates and insecure_skip_verify cannot be combined with WithTransport, configure TLS on the transport instead")
+	}
+
+	timeout := time.Second * time.Duration(clientTimeout)
+	if options.httpClient != nil {
+		if timeout != 0 && options.httpClient.Timeout != 0 && timeout != options.httpClient.Timeout {
+			return nil, fmt.Errorf("client timeout of %s conflicts with the timeout of %s of the client passed to WithHTTPClient", timeout, options.httpClient.Timeout)
+		}
+		// Copy the client, so that the jar and transport set below do not
+		// change the caller's client.
+		httpClient := *options.httpClient
+		if httpClient.Timeout == 0 {
+			httpClient.Timeout = timeout
+		}
+		retryClient.HTTPClient = &httpClient
+	} else {
+		tlsConfig, err := newTlsConfig(caCert, options)
+		if err != nil {
+			return nil, err
+		}
+
+		transport := &http.Transport{
+			TLSClientConfig: tlsConfig,
+			Proxy:           http.ProxyFromEnvironment,
+		}
+
+		retryClient.HTTPClient.Timeout = timeout
+		retryClient.HTTPClient.Transport = transport
+	}
+
+	if options.transport != nil {
+		retryClient.HTTPClient.Transport = options.transport
+	}
+
+	// the JWT from login is sent as a cookie
+	if retryClient.HTTPClient.Jar == nil {
+		cookieJar, err := cookiejar.New(&cookiejar.Options{
+			PublicSuffixList: publicsuffix.List,
+		})
+		if err != nil {
+			return nil, err
+		}
+		retryClient.HTTPClient.Jar = cookieJar
 	}
 
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{},
-		Proxy:           http.ProxyFromEnvironment,
+	return retryClient, nil
+}
+
+func newTlsConfig(caCert string, options *clientOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: options.insecureSkipVerify,
 	}
 
 	if caCert != "" {
 		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM([]byte(caCert))
-		transport.TLSClientConfig.RootCAs = caCertPool
+		if !caCertPool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("ca_cert does not contain any PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = caCertPool
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = 5
-	retryClient.RetryWaitMin = time.Second * 1
-	retryClient.RetryWaitMax = time.Second * 3
-
-	// httpClient := retryClient.StandardClient()
-	// httpClient.Timeout = time.Second * time.Duration(clientTimeout)
-	// httpClient.Transport = transport
-	// httpClient.Jar = cookieJar
-	retryClient.HTTPClient.Timeout = time.Second * time.Duration(clientTimeout)
-	retryClient.HTTPClient.Transport = transport
-	retryClient.HTTPClient.Jar = cookieJar
+	if options.clientCert != "" || options.clientKey != "" {
+		if options.clientCert == "" || options.clientKey == "" {
+			return nil, fmt.Errorf("both client_cert and client_key must be set for client certificate authentication")
+		}
+		certPEM, err := readPEM(options.clientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client_cert: %v", err)
+		}
+		keyPEM, err := readPEM(options.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client_key: %v", err)
+		}
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
 
-	return retryClient, nil
+	return tlsConfig, nil
 }
+
+// readPEM returns value if it holds PEM data, otherwise the content of the
+// file it names.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return ioutil.ReadFile(value)
+}
+
+// getRoot fetches the Wiki.js start page, which shows the setup wizard until
+// the initial setup has been finalized.
+func (wikijsClient *WikijsClient) getRoot() (*http.Response, error) {
+	request, err := retryablehttp.NewRequest(http.MethodGet, wikijsClient.host+"/", nil)
+	if err != nil {
+		return nil, err
+	}
+	if wikijsClient.userAgent != "" {
+		request.Header.Set("User-Agent", wikijsClient.userAgent)
+	}
+	return wikijsClient.retryablehttpClient.Do(request)
+}
+
 func (wikijsClient *WikijsClient) RequiresSetup() (bool, error) {
-	response, err := wikijsClient.retryablehttpClient.Get(wikijsClient.host + "/")
+	response, err := wikijsClient.getRoot()
 	if err != nil {
 		return true, fmt.Errorf("failed to connect to wikijs: %v", err)
 	}
+	defer response.Body.Close()
 
 	responseBody, readErr := ioutil.ReadAll(response.Body)
 	if readErr != nil {
@@ -286,7 +385,9 @@ func (wikijsClient *WikijsClient) SetupDone() (bool, error) {
 	if err != nil {
 		return false, fmt.Errorf("failed to connect to wikijs: %v", err)
 	}
-	log.Printf("SetupDone, RequiresSetup %v", requiresSetup)
+	wikijsClient.logger.Debug("Checked setup state", map[string]interface{}{
+		"requires_setup": requiresSetup,
+	})
 	if !requiresSetup {
 		return true, nil
 	}
@@ -309,7 +410,7 @@ func (wikijsClient *WikijsClient) get(path string, params map[string]string) ([]
 		request.URL.RawQuery = query.Encode()
 	}
 
-	body, _, err := wikijsClient.sendRequest(request, nil)
+	body, _, err := wikijsClient.sendRequest(request, nil, map[string]interface{}{})
 	if err != nil {
 		return nil, err
 	}
@@ -329,13 +430,107 @@ func (wikijsClient *WikijsClient) post(path string, requestBody interface{}) ([]
 		return nil, "", err
 	}
 
-	body, location, err := wikijsClient.sendRequest(request, payload)
+	body, location, err := wikijsClient.sendRequest(request, payload, requestLogFields(requestBody))
 	if err != nil {
 		return nil, "", err
 	}
 	return body, location, nil
 }
 
+// multipartField is a form value of a multipart request.
+type multipartField struct {
+	name  string
+	value string
+}
+
+// multipartFile is a file of a multipart request. open is called for every
+// attempt, so that retries send the whole content again.
+type multipartFile struct {
+	fieldName string
+	filename  string
+	open      func() (io.ReadCloser, error)
+}
+
+// postMultipart posts fields followed by files as multipart/form-data. The
+// body is streamed, so files are never loaded into memory as a whole.
+func (wikijsClient *WikijsClient) postMultipart(path string, fields []multipartField, files []multipartFile, logFields map[string]interface{}) ([]byte, error) {
+	resourceUrl := wikijsClient.host + path
+
+	request, err := retryablehttp.NewRequest(http.MethodPost, resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the boundary must stay the same across attempts to match the header
+	writer := multipart.NewWriter(ioutil.Discard)
+	err = request.SetBody(retryablehttp.ReaderFunc(func() (io.Reader, error) {
+		return multipartBody(writer.Boundary(), fields, files)
+	}))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-type", writer.FormDataContentType())
+
+	body, _, err := wikijsClient.sendRequest(request, nil, logFields)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// multipartBody opens the files and writes the parts into a pipe as they are
+// read. The writer stops when the HTTP client closes the returned reader.
+func multipartBody(boundary string, fields []multipartField, files []multipartFile) (io.ReadCloser, error) {
+	readers := []io.ReadCloser{}
+	closeReaders := func() {
+		for _, reader := range readers {
+			reader.Close()
+		}
+	}
+	for _, file := range files {
+		reader, err := file.open()
+		if err != nil {
+			closeReaders()
+			return nil, err
+		}
+		readers = append(readers, reader)
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+	err := writer.SetBoundary(boundary)
+	if err != nil {
+		closeReaders()
+		return nil, err
+	}
+
+	go func() {
+		defer closeReaders()
+		pipeWriter.CloseWithError(writeMultipart(writer, fields, files, readers))
+	}()
+	return pipeReader, nil
+}
+
+func writeMultipart(writer *multipart.Writer, fields []multipartField, files []multipartFile, readers []io.ReadCloser) error {
+	for _, field := range fields {
+		err := writer.WriteField(field.name, field.value)
+		if err != nil {
+			return err
+		}
+	}
+	for i, file := range files {
+		part, err := writer.CreateFormFile(file.fieldName, file.filename)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(part, readers[i])
+		if err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
 func (wikijsClient *WikijsClient) marshal(requestBody interface{}) ([]byte, error) {
 
 	return json.Marshal(requestBody)
@@ -348,31 +543,45 @@ func (wikijsClient *WikijsClient) addRequestHeaders(request *retryablehttp.Reque
 		request.Header.Set("Authorization", "Bearer "+wikijsClient.clientCredentials.ApiToken)
 	}
 
-	// if wikijsClient.userAgent != "" {
-	// 	request.Header.Set("User-Agent", wikijsClient.userAgent)
-	// }
+	if wikijsClient.userAgent != "" {
+		request.Header.Set("User-Agent", wikijsClient.userAgent)
+	}
 
+	// uploads set their own multipart content type
+	if request.Header.Get("Content-type") != "" {
+		return
+	}
 	if request.Method == http.MethodPost || request.Method == http.MethodPut || request.Method == http.MethodDelete {
 		request.Header.Set("Content-type", "application/json")
 	}
 }
 
-func (wikijsClient *WikijsClient) sendRequest(request *retryablehttp.Request, body []byte) ([]byte, string, error) {
+func (wikijsClient *WikijsClient) sendRequest(request *retryablehttp.Request, body []byte, logFields map[string]interface{}) ([]byte, string, error) {
 
-	requestMethod := request.Method
-	requestPath := request.URL.Path
+	logFields["method"] = request.Method
+	logFields["path"] = request.URL.Path
 
-	log.Printf("[DEBUG] Sending %s to %s", requestMethod, requestPath)
+	wikijsClient.logger.Debug("Sending request", logFields)
 	if body != nil {
-		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		// SetBody lets retries rewind the body, setting request.Body would
+		// send an empty body on retries.
+		err := request.SetBody(body)
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
 	wikijsClient.addRequestHeaders(request)
+	start := time.Now()
 	response, err := wikijsClient.retryablehttpClient.Do(request)
+	logFields["duration_ms"] = time.Since(start).Milliseconds()
 	if err != nil {
-		log.Printf("[DEBUG] failed doing Do: %s", err)
+		logFields["error"] = err.Error()
+		wikijsClient.logger.Error("Request failed", logFields)
 		return nil, "", fmt.Errorf("error sending request: %v", err)
 	}
+	logFields["status"] = response.StatusCode
+	wikijsClient.logger.Debug("Received response", logFields)
 
 	defer response.Body.Close()
 
@@ -391,6 +600,7 @@ func (wikijsClient *WikijsClient) sendRequest(request *retryablehttp.Request, bo
 		return nil, "", &ApiError{
 			Code:    response.StatusCode,
 			Message: errorMessage,
+			Body:    responseBody,
 		}
 	}
 
@@ -0,0 +1,109 @@
+package wikijs
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultRetryMax     = 5
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 3 * time.Second
+)
+
+// ClientOption configures optional behaviour of a WikijsClient.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	httpClient   *http.Client
+	transport    http.RoundTripper
+	retryMax     int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	userAgent    string
+	logger       Logger
+
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+}
+
+func newClientOptions(opts []ClientOption) *clientOptions {
+	options := &clientOptions{
+		retryMax:     DefaultRetryMax,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		logger:       NewTflogLogger(context.Background()),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithHTTPClient uses a copy of httpClient for all requests instead of
+// building one. A cookie jar is added to the copy if it has none, as the JWT
+// from login is sent as a cookie. The client timeout is used if httpClient
+// has none. TLS settings like ca_cert must be configured on httpClient, the
+// client fails to build if they are passed as well.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(options *clientOptions) {
+		options.httpClient = httpClient
+	}
+}
+
+// WithTransport replaces the HTTP transport used to talk to Wiki.js, e.g. to
+// record or replay requests in tests. TLS settings like ca_cert must be
+// configured on the transport, the client fails to build if they are passed
+// as well.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(options *clientOptions) {
+		options.transport = transport
+	}
+}
+
+// WithRetry sets how often failed requests are retried and how long to wait
+// in between.
+func WithRetry(retryMax int, waitMin, waitMax time.Duration) ClientOption {
+	return func(options *clientOptions) {
+		options.retryMax = retryMax
+		options.retryWaitMin = waitMin
+		options.retryWaitMax = waitMax
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(options *clientOptions) {
+		options.userAgent = userAgent
+	}
+}
+
+// WithLogger sends the client's log output to logger. By default the client
+// only logs when used from within the provider, see NewTflogLogger.
+func WithLogger(logger Logger) ClientOption {
+	return func(options *clientOptions) {
+		options.logger = logger
+	}
+}
+
+// WithClientCertificate presents a client certificate to Wiki.js, e.g. to an
+// ingress requiring mutual TLS. cert and key are either PEM encoded or the
+// path of a PEM file. It cannot be combined with WithHTTPClient or
+// WithTransport.
+func WithClientCertificate(cert, key string) ClientOption {
+	return func(options *clientOptions) {
+		options.clientCert = cert
+		options.clientKey = key
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the Wiki.js server
+// certificate. Only use it against development instances. It cannot be
+// combined with WithHTTPClient or WithTransport.
+func WithInsecureSkipVerify(insecureSkipVerify bool) ClientOption {
+	return func(options *clientOptions) {
+		options.insecureSkipVerify = insecureSkipVerify
+	}
+}
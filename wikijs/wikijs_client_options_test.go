@@ -0,0 +1,183 @@
+package wikijs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+	"github.com/stretchr/testify/assert"
+)
+
+type userAgentRecorder struct {
+	userAgents []string
+}
+
+func (r *userAgentRecorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	r.userAgents = append(r.userAgents, request.Header.Get("User-Agent"))
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func TestClientOptions(t *testing.T) {
+	server := wikijstest.NewServer()
+	defer server.Close()
+
+	transport := &userAgentRecorder{}
+	logger := &testLogger{}
+	httpClient := &http.Client{}
+
+	client, err := NewWikijsClient(server.URL, wikijstest.DefaultAdminEmail, wikijstest.DefaultAdminPassword, true, 10, "",
+		WithHTTPClient(httpClient),
+		WithTransport(transport),
+		WithRetry(2, 10*time.Millisecond, 20*time.Millisecond),
+		WithUserAgent("terraform-provider-wikijs/test"),
+		WithLogger(logger),
+	)
+	assert.Nil(t, err)
+	if assert.NotNil(t, client) {
+		// The client is copied, leaving the caller's client unchanged.
+		assert.NotSame(t, httpClient, client.retryablehttpClient.HTTPClient)
+		assert.Nil(t, httpClient.Jar)
+		assert.Nil(t, httpClient.Transport)
+		assert.NotNil(t, client.retryablehttpClient.HTTPClient.Jar, "a cookie jar should be added for the JWT")
+		assert.Equal(t, transport, client.retryablehttpClient.HTTPClient.Transport)
+		assert.Equal(t, 10*time.Second, client.retryablehttpClient.HTTPClient.Timeout)
+		assert.Equal(t, 2, client.retryablehttpClient.RetryMax)
+		assert.Equal(t, 10*time.Millisecond, client.retryablehttpClient.RetryWaitMin)
+		assert.Equal(t, 20*time.Millisecond, client.retryablehttpClient.RetryWaitMax)
+	}
+
+	assert.NotEmpty(t, transport.userAgents)
+	for _, userAgent := range transport.userAgents {
+		assert.Equal(t, "terraform-provider-wikijs/test", userAgent)
+	}
+	assert.Contains(t, logger.messages, "Sending request")
+}
+
+func TestConflictingClientOptions(t *testing.T) {
+	server := wikijstest.NewServer()
+	defer server.Close()
+
+	_, err := wikiJsClient(server.URL, 10, "", WithHTTPClient(&http.Client{Timeout: 5 * time.Second}))
+	assert.EqualError(t, err, "failed to create http client: client timeout of 10s conflicts with the timeout of 5s of the client passed to WithHTTPClient")
+
+	_, err = wikiJsClient(server.URL, 10, "", WithHTTPClient(&http.Client{}), WithInsecureSkipVerify(true))
+	assert.NotNil(t, err)
+
+	_, err = wikiJsClient(server.URL, 10, "", WithTransport(http.DefaultTransport), WithInsecureSkipVerify(true))
+	assert.NotNil(t, err)
+
+	clientCert, clientKey := newClientCertificate(t)
+	_, err = wikiJsClient(server.URL, 10, "", WithTransport(http.DefaultTransport), WithClientCertificate(clientCert, clientKey))
+	assert.NotNil(t, err)
+
+	client, err := wikiJsClient(server.URL, 10, "", WithHTTPClient(&http.Client{Timeout: 10 * time.Second}))
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestDefaultClientOptions(t *testing.T) {
+	options := newClientOptions(nil)
+	assert.Equal(t, DefaultRetryMax, options.retryMax)
+	assert.Equal(t, DefaultRetryWaitMin, options.retryWaitMin)
+	assert.Equal(t, DefaultRetryWaitMax, options.retryWaitMax)
+	assert.Nil(t, options.httpClient)
+	assert.Nil(t, options.transport)
+	assert.NotNil(t, options.logger)
+}
+
+// newClientCertificate returns a self-signed client certificate and key in
+// PEM encoding.
+func newClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestClientTls(t *testing.T) {
+	clientCert, clientKey := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	assert.True(t, clientCAs.AppendCertsFromPEM([]byte(clientCert)))
+
+	server := wikijstest.NewTLSServer(clientCAs)
+	defer server.Close()
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	noRetry := WithRetry(0, time.Millisecond, time.Millisecond)
+
+	// unknown server certificate
+	_, err := wikiJsClient(server.URL, 10, "", noRetry, WithClientCertificate(clientCert, clientKey))
+	assert.NotNil(t, err)
+
+	// no client certificate
+	_, err = wikiJsClient(server.URL, 10, caCert, noRetry)
+	assert.NotNil(t, err)
+
+	client, err := wikiJsClient(server.URL, 10, caCert, noRetry, WithClientCertificate(clientCert, clientKey))
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+
+	client, err = wikiJsClient(server.URL, 10, "", noRetry, WithClientCertificate(clientCert, clientKey), WithInsecureSkipVerify(true))
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+
+	// certificate and key from files
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	assert.Nil(t, ioutil.WriteFile(certFile, []byte(clientCert), 0600))
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte(clientKey), 0600))
+	client, err = wikiJsClient(server.URL, 10, caCert, noRetry, WithClientCertificate(certFile, keyFile))
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestNewTlsConfig(t *testing.T) {
+	clientCert, clientKey := newClientCertificate(t)
+
+	_, err := newTlsConfig("not a certificate", newClientOptions(nil))
+	assert.EqualError(t, err, "ca_cert does not contain any PEM encoded certificates")
+
+	_, err = newTlsConfig("", newClientOptions([]ClientOption{WithClientCertificate(clientCert, "")}))
+	assert.NotNil(t, err)
+
+	_, err = newTlsConfig("", newClientOptions([]ClientOption{WithClientCertificate(clientCert, clientCert)}))
+	assert.NotNil(t, err)
+
+	_, err = newTlsConfig("", newClientOptions([]ClientOption{WithClientCertificate("/does/not/exist.crt", clientKey)}))
+	assert.NotNil(t, err)
+
+	tlsConfig, err := newTlsConfig(clientCert, newClientOptions([]ClientOption{WithClientCertificate(clientCert, clientKey), WithInsecureSkipVerify(true)}))
+	assert.Nil(t, err)
+	if assert.NotNil(t, tlsConfig) {
+		assert.NotNil(t, tlsConfig.RootCAs)
+		assert.Len(t, tlsConfig.Certificates, 1)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+	}
+}
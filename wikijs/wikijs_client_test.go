@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -14,6 +15,7 @@ type WikijsClientTestSuite struct {
 	Username string
 	Password string
 	Client   *WikijsClient
+	Server   *wikijstest.Server
 }
 
 func TestWikijsClientTestSuite(t *testing.T) {
@@ -24,6 +26,12 @@ func (suite *WikijsClientTestSuite) SetupSuite() {
 	suite.Host = os.Getenv("WIKIJS_HOST")
 	suite.Username = os.Getenv("WIKIJS_USERNAME")
 	suite.Password = os.Getenv("WIKIJS_PASSWORD")
+	if suite.Host == "" {
+		suite.Server = wikijstest.NewServer()
+		suite.Host = suite.Server.URL
+		suite.Username = wikijstest.DefaultAdminEmail
+		suite.Password = wikijstest.DefaultAdminPassword
+	}
 
 	client, err := NewWikijsClient(suite.Host, suite.Username, suite.Password, true, 10, "")
 	assert.Nil(suite.T(), err)
@@ -35,6 +43,12 @@ func (suite *WikijsClientTestSuite) SetupSuite() {
 	}
 }
 
+func (suite *WikijsClientTestSuite) TearDownSuite() {
+	if suite.Server != nil {
+		suite.Server.Close()
+	}
+}
+
 func (suite *WikijsClientTestSuite) TesWikiJsClient() {
 	client, err := wikiJsClient(suite.Host, 10, "")
 	assert.Nil(suite.T(), err)
@@ -0,0 +1,132 @@
+package wikijs
+
+type CommentProvider struct {
+	IsEnabled   bool           `json:"isEnabled"`
+	Key         string         `json:"key"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Logo        string         `json:"logo"`
+	Website     string         `json:"website"`
+	IsAvailable bool           `json:"isAvailable"`
+	Config      []KeyValuePair `json:"config"`
+}
+
+type GetCommentProviders struct {
+	Data struct {
+		Comments struct {
+			Providers []CommentProvider `json:"providers"`
+		} `json:"comments"`
+	} `json:"data"`
+}
+
+// CommentProviderInput holds the settings of a comment provider to update.
+// The config values are encoded with EncodeModuleConfig.
+type CommentProviderInput struct {
+	IsEnabled bool           `json:"isEnabled"`
+	Key       string         `json:"key"`
+	Config    []KeyValuePair `json:"config"`
+}
+
+type UpdateCommentProvidersVariables struct {
+	Providers []CommentProviderInput `json:"providers"`
+}
+
+type UpdateCommentProvidersResult struct {
+	Data struct {
+		Comments struct {
+			UpdateProviders struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateProviders"`
+		} `json:"comments"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetCommentProviders() ([]CommentProvider, error) {
+	getCommentProvidersData := GraphQl{
+		Query: `
+{
+	comments {
+		providers {
+			isEnabled
+			key
+			title
+			description
+			logo
+			website
+			isAvailable
+			config {
+				key
+				value
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getCommentProviders GetCommentProviders
+	err := wikijsClient.postGraphQl(getCommentProvidersData, &getCommentProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	return getCommentProviders.Data.Comments.Providers, nil
+}
+
+// UpdateCommentProviders updates the comment providers. Wiki.js expects the
+// full list of providers, see CommentProviderInputs, and uses the enabled
+// one.
+func (wikijsClient *WikijsClient) UpdateCommentProviders(providers []CommentProviderInput) error {
+	updateCommentProvidersData := GraphQl{
+		Variables: UpdateCommentProvidersVariables{Providers: providers},
+		Query: `
+mutation ($providers: [CommentProviderInput]) {
+	comments {
+		updateProviders(providers: $providers) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateCommentProvidersResult UpdateCommentProvidersResult
+	err := wikijsClient.postGraphQl(updateCommentProvidersData, &updateCommentProvidersResult)
+	if err != nil {
+		return err
+	}
+
+	return updateCommentProvidersResult.Data.Comments.UpdateProviders.ResponseResult.Err()
+}
+
+// CommentProviderInputs returns the providers as input for
+// UpdateCommentProviders, so that a single provider can be changed while
+// keeping the others.
+func CommentProviderInputs(providers []CommentProvider) ([]CommentProviderInput, error) {
+	inputs := []CommentProviderInput{}
+	for _, provider := range providers {
+		properties, err := DecodeModuleConfig(provider.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := EncodeModuleConfig(ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, CommentProviderInput{
+			IsEnabled: provider.IsEnabled,
+			Key:       provider.Key,
+			Config:    config,
+		})
+	}
+	return inputs, nil
+}
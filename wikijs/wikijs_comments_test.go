@@ -0,0 +1,47 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestCommentProviders() {
+
+	providers, err := suite.Client.GetCommentProviders()
+	assert.Nil(suite.T(), err)
+	original, err := CommentProviderInputs(providers)
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateCommentProviders(original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	inputs, err := CommentProviderInputs(providers)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		inputs[i].IsEnabled = inputs[i].Key == "disqus"
+		if inputs[i].Key == "disqus" {
+			inputs[i].Config, err = EncodeModuleConfig(map[string]interface{}{
+				"accountName": "example-wiki",
+			})
+			assert.Nil(suite.T(), err)
+		}
+	}
+	err = suite.Client.UpdateCommentProviders(inputs)
+	assert.Nil(suite.T(), err)
+
+	providers, err = suite.Client.GetCommentProviders()
+	assert.Nil(suite.T(), err)
+	enabled := []string{}
+	for _, provider := range providers {
+		if provider.IsEnabled {
+			enabled = append(enabled, provider.Key)
+		}
+		if provider.Key != "disqus" {
+			continue
+		}
+		properties, err := DecodeModuleConfig(provider.Config)
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "example-wiki", ModuleConfigValues(properties)["accountName"])
+	}
+	assert.Equal(suite.T(), []string{"disqus"}, enabled)
+}
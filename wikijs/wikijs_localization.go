@@ -0,0 +1,233 @@
+package wikijs
+
+import (
+	"fmt"
+	"time"
+)
+
+// LocalePollInterval is the time between checks whether a downloaded locale
+// has been installed.
+var LocalePollInterval = 2 * time.Second
+
+type Locale struct {
+	Availability int64  `json:"availability"`
+	Code         string `json:"code"`
+	CreatedAt    string `json:"createdAt"`
+	InstallDate  string `json:"installDate"`
+	IsInstalled  bool   `json:"isInstalled"`
+	IsRTL        bool   `json:"isRTL"`
+	Name         string `json:"name"`
+	NativeName   string `json:"nativeName"`
+	UpdatedAt    string `json:"updatedAt"`
+}
+
+type GetLocales struct {
+	Data struct {
+		Localization struct {
+			Locales []Locale `json:"locales"`
+		} `json:"localization"`
+	} `json:"data"`
+}
+
+type LocalizationConfig struct {
+	Locale      string   `json:"locale"`
+	AutoUpdate  bool     `json:"autoUpdate"`
+	Namespacing bool     `json:"namespacing"`
+	Namespaces  []string `json:"namespaces"`
+}
+
+type GetLocalizationConfig struct {
+	Data struct {
+		Localization struct {
+			Config LocalizationConfig `json:"config"`
+		} `json:"localization"`
+	} `json:"data"`
+}
+
+type DownloadLocaleVariables struct {
+	Locale string `json:"locale"`
+}
+
+type DownloadLocaleResult struct {
+	Data struct {
+		Localization struct {
+			DownloadLocale struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"downloadLocale"`
+		} `json:"localization"`
+	} `json:"data"`
+}
+
+type UpdateLocaleResult struct {
+	Data struct {
+		Localization struct {
+			UpdateLocale struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateLocale"`
+		} `json:"localization"`
+	} `json:"data"`
+}
+
+// GetLocales returns all locales available for download, including the
+// installed ones.
+func (wikijsClient *WikijsClient) GetLocales() ([]Locale, error) {
+
+	getLocalesData := GraphQl{
+		Query: `
+{
+	localization {
+		locales {
+			availability
+			code
+			createdAt
+			installDate
+			isInstalled
+			isRTL
+			name
+			nativeName
+			updatedAt
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getLocales GetLocales
+	err := wikijsClient.postGraphQl(getLocalesData, &getLocales)
+	if err != nil {
+		return nil, err
+	}
+
+	return getLocales.Data.Localization.Locales, nil
+}
+
+func (wikijsClient *WikijsClient) GetLocalizationConfig() (*LocalizationConfig, error) {
+
+	getLocalizationConfigData := GraphQl{
+		Query: `
+{
+	localization {
+		config {
+			locale
+			autoUpdate
+			namespacing
+			namespaces
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getLocalizationConfig GetLocalizationConfig
+	err := wikijsClient.postGraphQl(getLocalizationConfigData, &getLocalizationConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getLocalizationConfig.Data.Localization.Config, nil
+}
+
+// DownloadLocale starts downloading and installing a locale, see
+// WaitForLocale.
+func (wikijsClient *WikijsClient) DownloadLocale(code string) error {
+
+	downloadLocaleData := GraphQl{
+		Variables: DownloadLocaleVariables{Locale: code},
+		Query: `
+mutation ($locale: String!) {
+	localization {
+		downloadLocale(locale: $locale) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var downloadLocaleResult DownloadLocaleResult
+	err := wikijsClient.postGraphQl(downloadLocaleData, &downloadLocaleResult)
+	if err != nil {
+		return err
+	}
+
+	return downloadLocaleResult.Data.Localization.DownloadLocale.ResponseResult.Err()
+}
+
+// WaitForLocale waits until a locale is installed, checking every
+// LocalePollInterval.
+func (wikijsClient *WikijsClient) WaitForLocale(code string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		locales, err := wikijsClient.GetLocales()
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, locale := range locales {
+			if locale.Code == code {
+				found = true
+				if locale.IsInstalled {
+					return nil
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("locale %s is not available", code)
+		}
+		if time.Now().Add(LocalePollInterval).After(deadline) {
+			return fmt.Errorf("locale %s was not installed within %s", code, timeout)
+		}
+		time.Sleep(LocalePollInterval)
+	}
+}
+
+// UpdateLocale sets the site locale and how content is organized by locale.
+func (wikijsClient *WikijsClient) UpdateLocale(config LocalizationConfig) error {
+
+	updateLocaleData := GraphQl{
+		Variables: config,
+		Query: `
+mutation (
+	$locale: String!
+	$autoUpdate: Boolean!
+	$namespacing: Boolean!
+	$namespaces: [String]!
+) {
+	localization {
+		updateLocale(
+			locale: $locale
+			autoUpdate: $autoUpdate
+			namespacing: $namespacing
+			namespaces: $namespaces
+		) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateLocaleResult UpdateLocaleResult
+	err := wikijsClient.postGraphQl(updateLocaleData, &updateLocaleResult)
+	if err != nil {
+		return err
+	}
+
+	return updateLocaleResult.Data.Localization.UpdateLocale.ResponseResult.Err()
+}
@@ -0,0 +1,70 @@
+package wikijs
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestLocales() {
+
+	locales, err := suite.Client.GetLocales()
+	assert.Nil(suite.T(), err)
+	installed := map[string]bool{}
+	for _, locale := range locales {
+		installed[locale.Code] = locale.IsInstalled
+		assert.NotEmpty(suite.T(), locale.Name)
+	}
+	assert.True(suite.T(), installed["en"])
+
+	err = suite.Client.WaitForLocale("xx-unknown", time.Second)
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *WikijsApiTestSuite) TestDownloadLocale() {
+	if suite.Server == nil {
+		suite.T().Skip("installing a locale needs the fake Wiki.js server")
+	}
+	suite.Server.DelayLocaleInstall(2)
+	defer suite.Server.DelayLocaleInstall(0)
+	pollInterval := LocalePollInterval
+	LocalePollInterval = 10 * time.Millisecond
+	defer func() { LocalePollInterval = pollInterval }()
+
+	err := suite.Client.DownloadLocale("ja")
+	assert.Nil(suite.T(), err)
+	err = suite.Client.WaitForLocale("ja", time.Second)
+	assert.Nil(suite.T(), err)
+
+	err = suite.Client.DownloadLocale("xx-unknown")
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *WikijsApiTestSuite) TestLocalizationConfig() {
+
+	original, err := suite.Client.GetLocalizationConfig()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), original) {
+		return
+	}
+	assert.NotEmpty(suite.T(), original.Locale)
+
+	err = suite.Client.UpdateLocale(LocalizationConfig{
+		Locale:      original.Locale,
+		AutoUpdate:  false,
+		Namespacing: true,
+		Namespaces:  []string{original.Locale},
+	})
+	assert.Nil(suite.T(), err)
+
+	config, err := suite.Client.GetLocalizationConfig()
+	assert.Nil(suite.T(), err)
+	if assert.NotNil(suite.T(), config) {
+		assert.False(suite.T(), config.AutoUpdate)
+		assert.True(suite.T(), config.Namespacing)
+		assert.Equal(suite.T(), []string{original.Locale}, config.Namespaces)
+	}
+
+	err = suite.Client.UpdateLocale(*original)
+	assert.Nil(suite.T(), err)
+}
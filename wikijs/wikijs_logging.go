@@ -0,0 +1,127 @@
+package wikijs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/internal/secrets"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the terraform-plugin-log subsystem the client logs to. Its
+// level can be set with TF_LOG_PROVIDER_WIKIJS.
+const LogSubsystem = "wikijs"
+
+// maskedValue replaces secrets in logged GraphQL variables.
+const maskedValue = "***"
+
+// Logger receives the structured log output of the client and of the
+// underlying retryablehttp client.
+type Logger interface {
+	Debug(msg string, fields map[string]interface{})
+	Warn(msg string, fields map[string]interface{})
+	Error(msg string, fields map[string]interface{})
+}
+
+type tflogLogger struct {
+	ctx context.Context
+}
+
+// NewTflogLogger returns a Logger writing to the LogSubsystem of the provider
+// logger in ctx. Without a provider logger in ctx, e.g. outside of Terraform,
+// nothing is logged.
+//
+// The client methods take no context, so every entry is logged with ctx and
+// its request fields. A logger created from the ConfigureProvider request
+// attributes the requests of later resource operations to that RPC, and its
+// level is read from TF_LOG_PROVIDER_WIKIJS once, when it is created.
+func NewTflogLogger(ctx context.Context) Logger {
+	return &tflogLogger{
+		ctx: tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "WIKIJS")),
+	}
+}
+
+func (l *tflogLogger) Debug(msg string, fields map[string]interface{}) {
+	tflog.SubsystemDebug(l.ctx, LogSubsystem, msg, fields)
+}
+
+func (l *tflogLogger) Warn(msg string, fields map[string]interface{}) {
+	tflog.SubsystemWarn(l.ctx, LogSubsystem, msg, fields)
+}
+
+func (l *tflogLogger) Error(msg string, fields map[string]interface{}) {
+	tflog.SubsystemError(l.ctx, LogSubsystem, msg, fields)
+}
+
+// retryLogger adapts a Logger to retryablehttp.LeveledLogger.
+type retryLogger struct {
+	logger Logger
+}
+
+func (l retryLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.Error(msg, keysAndValuesToFields(keysAndValues))
+}
+
+func (l retryLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.Warn(msg, keysAndValuesToFields(keysAndValues))
+}
+
+func (l retryLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValuesToFields(keysAndValues))
+}
+
+func (l retryLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.Debug(msg, keysAndValuesToFields(keysAndValues))
+}
+
+func keysAndValuesToFields(keysAndValues []interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	return fields
+}
+
+var operationRegexp = regexp.MustCompile(`^[^{]*\{\s*(\w+)\s*\{\s*(\w+)`)
+
+// operationName returns the namespace and first field of a GraphQL query,
+// e.g. "authentication.login".
+func operationName(query string) string {
+	match := operationRegexp.FindStringSubmatch(query)
+	if match == nil {
+		return ""
+	}
+	return match[1] + "." + match[2]
+}
+
+// requestLogFields describes a request body for the log, with secrets in
+// GraphQL variables masked.
+func requestLogFields(requestBody interface{}) map[string]interface{} {
+	graphQl, ok := requestBody.(GraphQl)
+	if !ok {
+		return map[string]interface{}{}
+	}
+	fields := map[string]interface{}{
+		"operation": operationName(graphQl.Query),
+	}
+	if graphQl.Variables != nil {
+		fields["variables"] = maskVariables(graphQl.Variables)
+	}
+	return fields
+}
+
+// maskVariables returns a copy of GraphQL variables with the secrets masked
+// by the rules of the secrets package.
+func maskVariables(variables interface{}) interface{} {
+	content, err := json.Marshal(variables)
+	if err != nil {
+		return maskedValue
+	}
+	var value interface{}
+	if err := json.Unmarshal(content, &value); err != nil {
+		return maskedValue
+	}
+	return secrets.Mask(value, func(interface{}) interface{} { return maskedValue })
+}
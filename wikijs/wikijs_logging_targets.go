@@ -0,0 +1,138 @@
+package wikijs
+
+// LogLevels are the levels of Wiki.js loggers, from the most to the least
+// severe.
+var LogLevels = []string{"error", "warn", "info", "verbose", "debug", "silly"}
+
+type LoggingTarget struct {
+	IsEnabled   bool           `json:"isEnabled"`
+	Key         string         `json:"key"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Logo        string         `json:"logo"`
+	Website     string         `json:"website"`
+	Level       string         `json:"level"`
+	Config      []KeyValuePair `json:"config"`
+}
+
+type GetLoggingTargets struct {
+	Data struct {
+		Logging struct {
+			Loggers []LoggingTarget `json:"loggers"`
+		} `json:"logging"`
+	} `json:"data"`
+}
+
+// LoggingTargetInput holds the settings of a logger to update. The config values
+// are encoded with EncodeModuleConfig.
+type LoggingTargetInput struct {
+	IsEnabled bool           `json:"isEnabled"`
+	Key       string         `json:"key"`
+	Level     string         `json:"level"`
+	Config    []KeyValuePair `json:"config"`
+}
+
+type UpdateLoggingTargetsVariables struct {
+	Loggers []LoggingTargetInput `json:"loggers"`
+}
+
+type UpdateLoggingTargetsResult struct {
+	Data struct {
+		Logging struct {
+			UpdateLoggers struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateLoggers"`
+		} `json:"logging"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetLoggingTargets() ([]LoggingTarget, error) {
+
+	getLoggingTargetsData := GraphQl{
+		Query: `
+{
+	logging {
+		loggers {
+			isEnabled
+			key
+			title
+			description
+			logo
+			website
+			level
+			config {
+				key
+				value
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getLoggingTargets GetLoggingTargets
+	err := wikijsClient.postGraphQl(getLoggingTargetsData, &getLoggingTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	return getLoggingTargets.Data.Logging.Loggers, nil
+}
+
+// UpdateLoggingTargets updates the loggers. Wiki.js expects the full list of
+// loggers, see LoggingTargetInputs.
+func (wikijsClient *WikijsClient) UpdateLoggingTargets(loggers []LoggingTargetInput) error {
+
+	updateLoggingTargetsData := GraphQl{
+		Variables: UpdateLoggingTargetsVariables{Loggers: loggers},
+		Query: `
+mutation ($loggers: [LoggingTargetInput]) {
+	logging {
+		updateLoggers(loggers: $loggers) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateLoggingTargetsResult UpdateLoggingTargetsResult
+	err := wikijsClient.postGraphQl(updateLoggingTargetsData, &updateLoggingTargetsResult)
+	if err != nil {
+		return err
+	}
+
+	return updateLoggingTargetsResult.Data.Logging.UpdateLoggers.ResponseResult.Err()
+}
+
+// LoggingTargetInputs returns the loggers as input for UpdateLoggingTargets, so that a
+// single logger can be changed while keeping the others.
+func LoggingTargetInputs(loggers []LoggingTarget) ([]LoggingTargetInput, error) {
+	inputs := []LoggingTargetInput{}
+	for _, logger := range loggers {
+		properties, err := DecodeModuleConfig(logger.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := EncodeModuleConfig(ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, LoggingTargetInput{
+			IsEnabled: logger.IsEnabled,
+			Key:       logger.Key,
+			Level:     logger.Level,
+			Config:    config,
+		})
+	}
+	return inputs, nil
+}
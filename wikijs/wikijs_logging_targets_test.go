@@ -0,0 +1,49 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestLoggingTargets() {
+
+	loggers, err := suite.Client.GetLoggingTargets()
+	assert.Nil(suite.T(), err)
+	original, err := LoggingTargetInputs(loggers)
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateLoggingTargets(original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	inputs, err := LoggingTargetInputs(loggers)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		if inputs[i].Key != "sentry" {
+			continue
+		}
+		inputs[i].IsEnabled = true
+		inputs[i].Level = "error"
+		inputs[i].Config, err = EncodeModuleConfig(map[string]interface{}{
+			"key": "https://public@sentry.example.com/1",
+		})
+		assert.Nil(suite.T(), err)
+	}
+	err = suite.Client.UpdateLoggingTargets(inputs)
+	assert.Nil(suite.T(), err)
+
+	loggers, err = suite.Client.GetLoggingTargets()
+	assert.Nil(suite.T(), err)
+	found := false
+	for _, logger := range loggers {
+		if logger.Key != "sentry" {
+			continue
+		}
+		found = true
+		assert.True(suite.T(), logger.IsEnabled)
+		assert.Equal(suite.T(), "error", logger.Level)
+		properties, err := DecodeModuleConfig(logger.Config)
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "https://public@sentry.example.com/1", ModuleConfigValues(properties)["key"])
+	}
+	assert.True(suite.T(), found)
+}
@@ -0,0 +1,74 @@
+package wikijs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	messages []string
+	fields   []map[string]interface{}
+}
+
+func (l *testLogger) log(msg string, fields map[string]interface{}) {
+	l.messages = append(l.messages, msg)
+	l.fields = append(l.fields, fields)
+}
+
+func (l *testLogger) Debug(msg string, fields map[string]interface{}) { l.log(msg, fields) }
+func (l *testLogger) Warn(msg string, fields map[string]interface{})  { l.log(msg, fields) }
+func (l *testLogger) Error(msg string, fields map[string]interface{}) { l.log(msg, fields) }
+
+func TestOperationName(t *testing.T) {
+	assert.Equal(t, "authentication.apiState", operationName("\n{\n\tauthentication {\n\t\tapiState\n\t}\n}"))
+	assert.Equal(t, "authentication.setApiState", operationName("mutation ($enabled: Boolean!) {\n authentication {\n setApiState(enabled: $enabled) {"))
+	assert.Equal(t, "", operationName("not a query"))
+}
+
+func TestMaskVariables(t *testing.T) {
+	masked := maskVariables(LoginVariables{
+		Username: "admin@wiki.example.local",
+		Password: "wikijsrocks",
+		Strategy: "local",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"username": "admin@wiki.example.local",
+		"password": maskedValue,
+		"strategy": "local",
+	}, masked)
+
+	masked = maskVariables(map[string]interface{}{
+		"config": []KeyValuePair{
+			{Key: "clientId", Value: `{"v":"wiki"}`},
+			{Key: "clientSecret", Value: `{"v":"secret"}`},
+		},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"config": []interface{}{
+			map[string]interface{}{"key": "clientId", "value": maskedValue},
+			map[string]interface{}{"key": "clientSecret", "value": maskedValue},
+		},
+	}, masked)
+
+	// Module config values are masked whatever their key, e.g. accountKey of
+	// Azure storage.
+	masked = maskVariables(UpdateStorageTargetsVariables{Targets: []StorageTargetInput{{
+		Key:    "azure",
+		Config: []KeyValuePair{{Key: "accountKey", Value: `{"v":"c2VjcmV0"}`}},
+	}}})
+	assert.NotContains(t, fmt.Sprint(masked), "c2VjcmV0")
+}
+
+func TestRequestLogFields(t *testing.T) {
+	fields := requestLogFields(GraphQl{
+		Variables: CreateApiKeyVariables{Name: "terraform", Expiration: "1y", FullAccess: true},
+		Query:     "mutation ($name: String!) { authentication { createApiKey(name: $name) { key } } }",
+	})
+	assert.Equal(t, "authentication.createApiKey", fields["operation"])
+	assert.NotNil(t, fields["variables"])
+
+	fields = requestLogFields(Finalize{AdminPassword: "wikijsrocks"})
+	assert.Empty(t, fields)
+}
@@ -0,0 +1,185 @@
+package wikijs
+
+// MaskedMailPassword is the password Wiki.js returns in place of the SMTP
+// password. Setting it keeps the stored password.
+const MaskedMailPassword = "********"
+
+type MailConfig struct {
+	SenderName      string `json:"senderName"`
+	SenderEmail     string `json:"senderEmail"`
+	Host            string `json:"host"`
+	Port            int64  `json:"port"`
+	Name            string `json:"name"`
+	Secure          bool   `json:"secure"`
+	VerifySSL       bool   `json:"verifySSL"`
+	User            string `json:"user"`
+	Pass            string `json:"pass"`
+	UseDKIM         bool   `json:"useDKIM"`
+	DKIMDomainName  string `json:"dkimDomainName"`
+	DKIMKeySelector string `json:"dkimKeySelector"`
+	DKIMPrivateKey  string `json:"dkimPrivateKey"`
+}
+
+type GetMailConfig struct {
+	Data struct {
+		Mail struct {
+			Config MailConfig `json:"config"`
+		} `json:"mail"`
+	} `json:"data"`
+}
+
+type UpdateMailConfigResult struct {
+	Data struct {
+		Mail struct {
+			UpdateConfig struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateConfig"`
+		} `json:"mail"`
+	} `json:"data"`
+}
+
+type SendTestMailVariables struct {
+	RecipientEmail string `json:"recipientEmail"`
+}
+
+type SendTestMailResult struct {
+	Data struct {
+		Mail struct {
+			SendTest struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"sendTest"`
+		} `json:"mail"`
+	} `json:"data"`
+}
+
+// GetMailConfig returns the SMTP settings. The password is returned as
+// MaskedMailPassword if one is set.
+func (wikijsClient *WikijsClient) GetMailConfig() (*MailConfig, error) {
+
+	getMailConfigData := GraphQl{
+		Query: `
+{
+	mail {
+		config {
+			senderName
+			senderEmail
+			host
+			port
+			name
+			secure
+			verifySSL
+			user
+			pass
+			useDKIM
+			dkimDomainName
+			dkimKeySelector
+			dkimPrivateKey
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getMailConfig GetMailConfig
+	err := wikijsClient.postGraphQl(getMailConfigData, &getMailConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getMailConfig.Data.Mail.Config, nil
+}
+
+// UpdateMailConfig replaces all SMTP settings. Pass MaskedMailPassword as
+// password to keep the stored one.
+func (wikijsClient *WikijsClient) UpdateMailConfig(config MailConfig) error {
+
+	updateMailConfigData := GraphQl{
+		Variables: config,
+		Query: `
+mutation (
+	$senderName: String!
+	$senderEmail: String!
+	$host: String!
+	$port: Int!
+	$name: String!
+	$secure: Boolean!
+	$verifySSL: Boolean!
+	$user: String!
+	$pass: String!
+	$useDKIM: Boolean!
+	$dkimDomainName: String!
+	$dkimKeySelector: String!
+	$dkimPrivateKey: String!
+) {
+	mail {
+		updateConfig(
+			senderName: $senderName
+			senderEmail: $senderEmail
+			host: $host
+			port: $port
+			name: $name
+			secure: $secure
+			verifySSL: $verifySSL
+			user: $user
+			pass: $pass
+			useDKIM: $useDKIM
+			dkimDomainName: $dkimDomainName
+			dkimKeySelector: $dkimKeySelector
+			dkimPrivateKey: $dkimPrivateKey
+		) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateMailConfigResult UpdateMailConfigResult
+	err := wikijsClient.postGraphQl(updateMailConfigData, &updateMailConfigResult)
+	if err != nil {
+		return err
+	}
+
+	return updateMailConfigResult.Data.Mail.UpdateConfig.ResponseResult.Err()
+}
+
+// SendTestMail sends a test mail with the current SMTP settings. It returns
+// an error if the mail could not be delivered to the SMTP server.
+func (wikijsClient *WikijsClient) SendTestMail(recipientEmail string) error {
+
+	sendTestMailData := GraphQl{
+		Variables: SendTestMailVariables{RecipientEmail: recipientEmail},
+		Query: `
+mutation ($recipientEmail: String!) {
+	mail {
+		sendTest(recipientEmail: $recipientEmail) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var sendTestMailResult SendTestMailResult
+	err := wikijsClient.postGraphQl(sendTestMailData, &sendTestMailResult)
+	if err != nil {
+		return err
+	}
+
+	return sendTestMailResult.Data.Mail.SendTest.ResponseResult.Err()
+}
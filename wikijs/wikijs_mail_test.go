@@ -0,0 +1,87 @@
+package wikijs
+
+import (
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestMailConfig() {
+
+	original, err := suite.Client.GetMailConfig()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), original) {
+		return
+	}
+	defer func() {
+		err := suite.Client.UpdateMailConfig(*original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	config := MailConfig{
+		SenderName:  "Wiki",
+		SenderEmail: "wiki@example.com",
+		Host:        "smtp.example.com",
+		Port:        587,
+		Name:        "wiki.example.com",
+		VerifySSL:   true,
+		User:        "wiki",
+		Pass:        "s3cret",
+	}
+	err = suite.Client.UpdateMailConfig(config)
+	assert.Nil(suite.T(), err)
+
+	current, err := suite.Client.GetMailConfig()
+	assert.Nil(suite.T(), err)
+	if assert.NotNil(suite.T(), current) {
+		assert.Equal(suite.T(), "smtp.example.com", current.Host)
+		assert.Equal(suite.T(), int64(587), current.Port)
+		assert.Equal(suite.T(), MaskedMailPassword, current.Pass)
+	}
+}
+
+func (suite *WikijsApiTestSuite) TestSendTestMail() {
+	if suite.Server == nil {
+		suite.T().Skip("sending mails needs the fake Wiki.js server to reach the local SMTP server")
+	}
+	smtpServer := wikijstest.NewSMTPServer("wiki", "s3cret")
+	defer smtpServer.Close()
+
+	original, err := suite.Client.GetMailConfig()
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateMailConfig(*original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	config := MailConfig{
+		SenderName:  "Wiki",
+		SenderEmail: "wiki@example.com",
+		Host:        smtpServer.Host,
+		Port:        smtpServer.Port,
+		User:        "wiki",
+		Pass:        "s3cret",
+	}
+	err = suite.Client.UpdateMailConfig(config)
+	assert.Nil(suite.T(), err)
+
+	// The masked password keeps the stored one.
+	config.Pass = MaskedMailPassword
+	err = suite.Client.UpdateMailConfig(config)
+	assert.Nil(suite.T(), err)
+
+	err = suite.Client.SendTestMail("admin@example.com")
+	assert.Nil(suite.T(), err)
+	messages := smtpServer.Messages()
+	if assert.Len(suite.T(), messages, 1) {
+		assert.Equal(suite.T(), "wiki@example.com", messages[0].From)
+		assert.Equal(suite.T(), []string{"admin@example.com"}, messages[0].To)
+		assert.Contains(suite.T(), messages[0].Data, "Subject: A test email from your wiki")
+	}
+
+	config.Pass = "wrong"
+	err = suite.Client.UpdateMailConfig(config)
+	assert.Nil(suite.T(), err)
+	err = suite.Client.SendTestMail("admin@example.com")
+	assert.NotNil(suite.T(), err)
+	assert.Len(suite.T(), smtpServer.Messages(), 1)
+}
@@ -0,0 +1,85 @@
+package wikijs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ModuleConfigProperty is a config value of a module like a storage target
+// or search engine, together with the description of the property Wiki.js
+// sends along when reading it.
+type ModuleConfigProperty struct {
+	Key       string
+	Type      string
+	Title     string
+	Hint      string
+	Sensitive bool
+	Enum      []string
+	Value     interface{}
+}
+
+type moduleConfigValue struct {
+	Type      string        `json:"type"`
+	Title     string        `json:"title"`
+	Hint      string        `json:"hint"`
+	Sensitive bool          `json:"sensitive"`
+	Enum      []interface{} `json:"enum"`
+	Value     interface{}   `json:"value"`
+}
+
+// DecodeModuleConfig decodes the config of a module as read from Wiki.js,
+// where each value is a JSON object with the property description and the
+// value itself.
+func DecodeModuleConfig(pairs []KeyValuePair) ([]ModuleConfigProperty, error) {
+	properties := []ModuleConfigProperty{}
+	for _, pair := range pairs {
+		var value moduleConfigValue
+		if err := json.Unmarshal([]byte(pair.Value), &value); err != nil {
+			return nil, fmt.Errorf("unable to decode config %s: %w", pair.Key, err)
+		}
+		property := ModuleConfigProperty{
+			Key:       pair.Key,
+			Type:      value.Type,
+			Title:     value.Title,
+			Hint:      value.Hint,
+			Sensitive: value.Sensitive,
+			Value:     value.Value,
+		}
+		for _, enum := range value.Enum {
+			// enum entries are either plain values or "value|label" strings
+			property.Enum = append(property.Enum, fmt.Sprint(enum))
+		}
+		properties = append(properties, property)
+	}
+	return properties, nil
+}
+
+// EncodeModuleConfig encodes config values for updating a module, wrapping
+// each value as {"v": value}. Keys are sorted to keep requests stable.
+func EncodeModuleConfig(values map[string]interface{}) ([]KeyValuePair, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := []KeyValuePair{}
+	for _, key := range keys {
+		value, err := json.Marshal(map[string]interface{}{"v": values[key]})
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode config %s: %w", key, err)
+		}
+		pairs = append(pairs, KeyValuePair{Key: key, Value: string(value)})
+	}
+	return pairs, nil
+}
+
+// ModuleConfigValues returns the values of properties by key.
+func ModuleConfigValues(properties []ModuleConfigProperty) map[string]interface{} {
+	values := map[string]interface{}{}
+	for _, property := range properties {
+		values[property.Key] = property.Value
+	}
+	return values
+}
@@ -0,0 +1,37 @@
+package wikijs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleConfig(t *testing.T) {
+	pairs := []KeyValuePair{
+		{Key: "branch", Value: `{"type":"String","title":"Branch","default":"master","order":4,"value":"main"}`},
+		{Key: "verifySSL", Value: `{"type":"Boolean","title":"Verify SSL Certificate","default":true,"order":5,"value":false}`},
+		{Key: "sshPrivateKeyContent", Value: `{"type":"String","title":"B - SSH Private Key Contents","sensitive":true,"multiline":true,"value":"secret"}`},
+		{Key: "authType", Value: `{"type":"String","title":"Authentication Type","enum":["basic","ssh"],"value":"ssh"}`},
+	}
+
+	properties, err := DecodeModuleConfig(pairs)
+	assert.Nil(t, err)
+	if assert.Len(t, properties, 4) {
+		assert.Equal(t, ModuleConfigProperty{Key: "branch", Type: "String", Title: "Branch", Value: "main"}, properties[0])
+		assert.Equal(t, false, properties[1].Value)
+		assert.True(t, properties[2].Sensitive)
+		assert.Equal(t, []string{"basic", "ssh"}, properties[3].Enum)
+	}
+
+	encoded, err := EncodeModuleConfig(ModuleConfigValues(properties))
+	assert.Nil(t, err)
+	assert.Equal(t, []KeyValuePair{
+		{Key: "authType", Value: `{"v":"ssh"}`},
+		{Key: "branch", Value: `{"v":"main"}`},
+		{Key: "sshPrivateKeyContent", Value: `{"v":"secret"}`},
+		{Key: "verifySSL", Value: `{"v":false}`},
+	}, encoded)
+
+	_, err = DecodeModuleConfig([]KeyValuePair{{Key: "broken", Value: "{"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,185 @@
+package wikijs
+
+type NavigationItem struct {
+	Id               string  `json:"id"`
+	Kind             string  `json:"kind"`
+	Label            string  `json:"label"`
+	Icon             string  `json:"icon"`
+	TargetType       string  `json:"targetType"`
+	Target           string  `json:"target"`
+	VisibilityMode   string  `json:"visibilityMode"`
+	VisibilityGroups []int64 `json:"visibilityGroups"`
+}
+
+type NavigationTree struct {
+	Locale string           `json:"locale"`
+	Items  []NavigationItem `json:"items"`
+}
+
+type GetNavigationTree struct {
+	Data struct {
+		Navigation struct {
+			Tree []NavigationTree `json:"tree"`
+		} `json:"navigation"`
+	} `json:"data"`
+}
+
+type UpdateNavigationTreeVariables struct {
+	Tree []NavigationTree `json:"tree"`
+}
+
+type UpdateNavigationTreeResult struct {
+	Data struct {
+		Navigation struct {
+			UpdateTree struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateTree"`
+		} `json:"navigation"`
+	} `json:"data"`
+}
+
+type NavigationConfig struct {
+	Mode string `json:"mode"`
+}
+
+type GetNavigationConfig struct {
+	Data struct {
+		Navigation struct {
+			Config NavigationConfig `json:"config"`
+		} `json:"navigation"`
+	} `json:"data"`
+}
+
+type UpdateNavigationConfigResult struct {
+	Data struct {
+		Navigation struct {
+			UpdateConfig struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateConfig"`
+		} `json:"navigation"`
+	} `json:"data"`
+}
+
+// GetNavigationTree returns the navigation items of all locales.
+func (wikijsClient *WikijsClient) GetNavigationTree() ([]NavigationTree, error) {
+
+	getNavigationTreeData := GraphQl{
+		Query: `
+{
+	navigation {
+		tree {
+			locale
+			items {
+				id
+				kind
+				label
+				icon
+				targetType
+				target
+				visibilityMode
+				visibilityGroups
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getNavigationTree GetNavigationTree
+	err := wikijsClient.postGraphQl(getNavigationTreeData, &getNavigationTree)
+	if err != nil {
+		return nil, err
+	}
+
+	return getNavigationTree.Data.Navigation.Tree, nil
+}
+
+// UpdateNavigationTree replaces the navigation items of all locales. Locales
+// missing from tree lose their navigation.
+func (wikijsClient *WikijsClient) UpdateNavigationTree(tree []NavigationTree) error {
+
+	updateNavigationTreeData := GraphQl{
+		Variables: UpdateNavigationTreeVariables{Tree: tree},
+		Query: `
+mutation ($tree: [NavigationTreeInput]!) {
+	navigation {
+		updateTree(tree: $tree) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateNavigationTreeResult UpdateNavigationTreeResult
+	err := wikijsClient.postGraphQl(updateNavigationTreeData, &updateNavigationTreeResult)
+	if err != nil {
+		return err
+	}
+
+	return updateNavigationTreeResult.Data.Navigation.UpdateTree.ResponseResult.Err()
+}
+
+func (wikijsClient *WikijsClient) GetNavigationConfig() (*NavigationConfig, error) {
+	getNavigationConfigData := GraphQl{
+		Query: `
+{
+	navigation {
+		config {
+			mode
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getNavigationConfig GetNavigationConfig
+	err := wikijsClient.postGraphQl(getNavigationConfigData, &getNavigationConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getNavigationConfig.Data.Navigation.Config, nil
+}
+
+// UpdateNavigationConfig sets the navigation mode, one of NONE, TREE, MIXED
+// or STATIC.
+func (wikijsClient *WikijsClient) UpdateNavigationConfig(mode string) error {
+	updateNavigationConfigData := GraphQl{
+		Variables: NavigationConfig{Mode: mode},
+		Query: `
+mutation ($mode: NavigationMode!) {
+	navigation {
+		updateConfig(mode: $mode) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateNavigationConfigResult UpdateNavigationConfigResult
+	err := wikijsClient.postGraphQl(updateNavigationConfigData, &updateNavigationConfigResult)
+	if err != nil {
+		return err
+	}
+
+	return updateNavigationConfigResult.Data.Navigation.UpdateConfig.ResponseResult.Err()
+}
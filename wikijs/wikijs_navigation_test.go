@@ -0,0 +1,46 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestNavigation() {
+
+	originalTree, err := suite.Client.GetNavigationTree()
+	assert.Nil(suite.T(), err)
+	originalConfig, err := suite.Client.GetNavigationConfig()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), originalConfig) {
+		return
+	}
+
+	tree := []NavigationTree{{
+		Locale: "en",
+		Items: []NavigationItem{
+			{Id: "header", Kind: "header", Label: "Docs", VisibilityMode: "all"},
+			{Id: "guide", Kind: "link", Label: "Guide", Icon: "mdi-book", TargetType: "page", Target: "/guide", VisibilityMode: "restricted", VisibilityGroups: []int64{1}},
+			{Id: "divider", Kind: "divider", VisibilityMode: "all"},
+		},
+	}}
+	err = suite.Client.UpdateNavigationTree(tree)
+	assert.Nil(suite.T(), err)
+	err = suite.Client.UpdateNavigationConfig("TREE")
+	assert.Nil(suite.T(), err)
+
+	navigationTree, err := suite.Client.GetNavigationTree()
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), tree, navigationTree)
+	navigationConfig, err := suite.Client.GetNavigationConfig()
+	assert.Nil(suite.T(), err)
+	if assert.NotNil(suite.T(), navigationConfig) {
+		assert.Equal(suite.T(), "TREE", navigationConfig.Mode)
+	}
+
+	err = suite.Client.UpdateNavigationConfig("SIDEWAYS")
+	assert.NotNil(suite.T(), err)
+
+	err = suite.Client.UpdateNavigationTree(originalTree)
+	assert.Nil(suite.T(), err)
+	err = suite.Client.UpdateNavigationConfig(originalConfig.Mode)
+	assert.Nil(suite.T(), err)
+}
@@ -0,0 +1,225 @@
+package wikijs
+
+type PageTag struct {
+	Id        int64  `json:"id"`
+	Tag       string `json:"tag"`
+	Title     string `json:"title"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type PageListItem struct {
+	Id     int64    `json:"id"`
+	Path   string   `json:"path"`
+	Locale string   `json:"locale"`
+	Title  string   `json:"title"`
+	Tags   []string `json:"tags"`
+}
+
+type GetPageTags struct {
+	Data struct {
+		Pages struct {
+			Tags []PageTag `json:"tags"`
+		} `json:"pages"`
+	} `json:"data"`
+}
+
+type SearchPageTagsVariables struct {
+	Query string `json:"query"`
+}
+
+type SearchPageTags struct {
+	Data struct {
+		Pages struct {
+			SearchTags []string `json:"searchTags"`
+		} `json:"pages"`
+	} `json:"data"`
+}
+
+type UpdatePageTagVariables struct {
+	Id    int64  `json:"id"`
+	Tag   string `json:"tag"`
+	Title string `json:"title"`
+}
+
+type UpdatePageTagResult struct {
+	Data struct {
+		Pages struct {
+			UpdateTag struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateTag"`
+		} `json:"pages"`
+	} `json:"data"`
+}
+
+type DeletePageTagVariables struct {
+	Id int64 `json:"id"`
+}
+
+type DeletePageTagResult struct {
+	Data struct {
+		Pages struct {
+			DeleteTag struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"deleteTag"`
+		} `json:"pages"`
+	} `json:"data"`
+}
+
+type GetPagesVariables struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+type GetPages struct {
+	Data struct {
+		Pages struct {
+			List []PageListItem `json:"list"`
+		} `json:"pages"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetPageTags() ([]PageTag, error) {
+
+	getPageTagsData := GraphQl{
+		Query: `
+{
+	pages {
+		tags {
+			id
+			tag
+			title
+			createdAt
+			updatedAt
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getPageTags GetPageTags
+	err := wikijsClient.postGraphQl(getPageTagsData, &getPageTags)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPageTags.Data.Pages.Tags, nil
+}
+
+// SearchPageTags returns the tags matching query.
+func (wikijsClient *WikijsClient) SearchPageTags(query string) ([]string, error) {
+
+	searchPageTagsData := GraphQl{
+		Variables: SearchPageTagsVariables{Query: query},
+		Query: `
+query ($query: String!) {
+	pages {
+		searchTags(query: $query)
+		__typename
+	}
+}`,
+	}
+
+	var searchPageTags SearchPageTags
+	err := wikijsClient.postGraphQl(searchPageTagsData, &searchPageTags)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchPageTags.Data.Pages.SearchTags, nil
+}
+
+// UpdatePageTag sets the tag and its display title.
+func (wikijsClient *WikijsClient) UpdatePageTag(id int64, tag, title string) error {
+
+	updatePageTagData := GraphQl{
+		Variables: UpdatePageTagVariables{Id: id, Tag: tag, Title: title},
+		Query: `
+mutation ($id: Int!, $tag: String!, $title: String!) {
+	pages {
+		updateTag(id: $id, tag: $tag, title: $title) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updatePageTagResult UpdatePageTagResult
+	err := wikijsClient.postGraphQl(updatePageTagData, &updatePageTagResult)
+	if err != nil {
+		return err
+	}
+
+	return updatePageTagResult.Data.Pages.UpdateTag.ResponseResult.Err()
+}
+
+// DeletePageTag deletes the tag, removing it from all pages.
+func (wikijsClient *WikijsClient) DeletePageTag(id int64) error {
+
+	deletePageTagData := GraphQl{
+		Variables: DeletePageTagVariables{Id: id},
+		Query: `
+mutation ($id: Int!) {
+	pages {
+		deleteTag(id: $id) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var deletePageTagResult DeletePageTagResult
+	err := wikijsClient.postGraphQl(deletePageTagData, &deletePageTagResult)
+	if err != nil {
+		return err
+	}
+
+	return deletePageTagResult.Data.Pages.DeleteTag.ResponseResult.Err()
+}
+
+// GetPages returns the pages having all of tags, or all pages if tags is
+// empty.
+func (wikijsClient *WikijsClient) GetPages(tags []string) ([]PageListItem, error) {
+
+	getPagesData := GraphQl{
+		Variables: GetPagesVariables{Tags: tags},
+		Query: `
+query ($tags: [String!]) {
+	pages {
+		list(tags: $tags) {
+			id
+			path
+			locale
+			title
+			tags
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getPages GetPages
+	err := wikijsClient.postGraphQl(getPagesData, &getPages)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPages.Data.Pages.List, nil
+}
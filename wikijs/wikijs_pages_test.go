@@ -0,0 +1,54 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestPageTags() {
+	if suite.Server == nil {
+		suite.T().Skip("tags are created with pages, which are seeded in the fake Wiki.js server")
+	}
+	suite.Server.AddPage("runbooks/failover", "Failover", "runbook", "database")
+	suite.Server.AddPage("runbooks/restore", "Restore", "runbook")
+
+	tags, err := suite.Client.GetPageTags()
+	assert.Nil(suite.T(), err)
+	var runbook *PageTag
+	for i := range tags {
+		if tags[i].Tag == "runbook" {
+			runbook = &tags[i]
+		}
+	}
+	if !assert.NotNil(suite.T(), runbook) {
+		return
+	}
+	assert.Equal(suite.T(), "runbook", runbook.Title)
+
+	found, err := suite.Client.SearchPageTags("RUN")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"runbook"}, found)
+
+	pages, err := suite.Client.GetPages([]string{"runbook", "database"})
+	assert.Nil(suite.T(), err)
+	if assert.Len(suite.T(), pages, 1) {
+		assert.Equal(suite.T(), "runbooks/failover", pages[0].Path)
+	}
+
+	err = suite.Client.UpdatePageTag(runbook.Id, "runbook", "Runbooks")
+	assert.Nil(suite.T(), err)
+	tags, err = suite.Client.GetPageTags()
+	assert.Nil(suite.T(), err)
+	for _, tag := range tags {
+		if tag.Id == runbook.Id {
+			assert.Equal(suite.T(), "Runbooks", tag.Title)
+		}
+	}
+
+	err = suite.Client.DeletePageTag(runbook.Id)
+	assert.Nil(suite.T(), err)
+	err = suite.Client.DeletePageTag(runbook.Id)
+	assert.NotNil(suite.T(), err)
+	pages, err = suite.Client.GetPages([]string{"runbook"})
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), pages)
+}
@@ -0,0 +1,134 @@
+package wikijs
+
+type Renderer struct {
+	IsEnabled   bool           `json:"isEnabled"`
+	Key         string         `json:"key"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Icon        string         `json:"icon"`
+	DependsOn   string         `json:"dependsOn"`
+	Input       string         `json:"input"`
+	Output      string         `json:"output"`
+	Config      []KeyValuePair `json:"config"`
+}
+
+type GetRenderers struct {
+	Data struct {
+		Rendering struct {
+			Renderers []Renderer `json:"renderers"`
+		} `json:"rendering"`
+	} `json:"data"`
+}
+
+// RendererInput holds the settings of a renderer to update. The config
+// values are encoded with EncodeModuleConfig.
+type RendererInput struct {
+	IsEnabled bool           `json:"isEnabled"`
+	Key       string         `json:"key"`
+	Config    []KeyValuePair `json:"config"`
+}
+
+type UpdateRenderersVariables struct {
+	Renderers []RendererInput `json:"renderers"`
+}
+
+type UpdateRenderersResult struct {
+	Data struct {
+		Rendering struct {
+			UpdateRenderers struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateRenderers"`
+		} `json:"rendering"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetRenderers() ([]Renderer, error) {
+
+	getRenderersData := GraphQl{
+		Query: `
+{
+	rendering {
+		renderers {
+			isEnabled
+			key
+			title
+			description
+			icon
+			dependsOn
+			input
+			output
+			config {
+				key
+				value
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getRenderers GetRenderers
+	err := wikijsClient.postGraphQl(getRenderersData, &getRenderers)
+	if err != nil {
+		return nil, err
+	}
+
+	return getRenderers.Data.Rendering.Renderers, nil
+}
+
+// UpdateRenderers updates the renderers. Wiki.js expects the full list of
+// renderers, see RendererInputs.
+func (wikijsClient *WikijsClient) UpdateRenderers(renderers []RendererInput) error {
+
+	updateRenderersData := GraphQl{
+		Variables: UpdateRenderersVariables{Renderers: renderers},
+		Query: `
+mutation ($renderers: [RendererInput]) {
+	rendering {
+		updateRenderers(renderers: $renderers) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateRenderersResult UpdateRenderersResult
+	err := wikijsClient.postGraphQl(updateRenderersData, &updateRenderersResult)
+	if err != nil {
+		return err
+	}
+
+	return updateRenderersResult.Data.Rendering.UpdateRenderers.ResponseResult.Err()
+}
+
+// RendererInputs returns the renderers as input for UpdateRenderers, so
+// that a single renderer can be changed while keeping the others.
+func RendererInputs(renderers []Renderer) ([]RendererInput, error) {
+	inputs := []RendererInput{}
+	for _, renderer := range renderers {
+		properties, err := DecodeModuleConfig(renderer.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := EncodeModuleConfig(ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, RendererInput{
+			IsEnabled: renderer.IsEnabled,
+			Key:       renderer.Key,
+			Config:    config,
+		})
+	}
+	return inputs, nil
+}
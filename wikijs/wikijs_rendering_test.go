@@ -0,0 +1,57 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestRenderers() {
+
+	renderers, err := suite.Client.GetRenderers()
+	assert.Nil(suite.T(), err)
+	original, err := RendererInputs(renderers)
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateRenderers(original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	dependsOn := map[string]string{}
+	for _, renderer := range renderers {
+		dependsOn[renderer.Key] = renderer.DependsOn
+	}
+	assert.Equal(suite.T(), "", dependsOn["htmlCore"])
+	assert.Equal(suite.T(), "htmlCore", dependsOn["markdownCore"])
+	assert.Equal(suite.T(), "markdownCore", dependsOn["markdownKroki"])
+
+	inputs, err := RendererInputs(renderers)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		if inputs[i].Key != "markdownKroki" {
+			continue
+		}
+		inputs[i].IsEnabled = true
+		inputs[i].Config, err = EncodeModuleConfig(map[string]interface{}{
+			"server":      "https://kroki.example.com",
+			"openMarker":  "```kroki",
+			"closeMarker": "```",
+		})
+		assert.Nil(suite.T(), err)
+	}
+	err = suite.Client.UpdateRenderers(inputs)
+	assert.Nil(suite.T(), err)
+
+	renderers, err = suite.Client.GetRenderers()
+	assert.Nil(suite.T(), err)
+	found := false
+	for _, renderer := range renderers {
+		if renderer.Key != "markdownKroki" {
+			continue
+		}
+		found = true
+		assert.True(suite.T(), renderer.IsEnabled)
+		properties, err := DecodeModuleConfig(renderer.Config)
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "https://kroki.example.com", ModuleConfigValues(properties)["server"])
+	}
+	assert.True(suite.T(), found)
+}
@@ -0,0 +1,174 @@
+package wikijs
+
+type SearchEngine struct {
+	IsEnabled   bool           `json:"isEnabled"`
+	Key         string         `json:"key"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Logo        string         `json:"logo"`
+	Website     string         `json:"website"`
+	IsAvailable bool           `json:"isAvailable"`
+	Config      []KeyValuePair `json:"config"`
+}
+
+type GetSearchEngines struct {
+	Data struct {
+		Search struct {
+			SearchEngines []SearchEngine `json:"searchEngines"`
+		} `json:"search"`
+	} `json:"data"`
+}
+
+// SearchEngineInput holds the settings of a search engine to update. The
+// config values are encoded with EncodeModuleConfig.
+type SearchEngineInput struct {
+	IsEnabled bool           `json:"isEnabled"`
+	Key       string         `json:"key"`
+	Config    []KeyValuePair `json:"config"`
+}
+
+type UpdateSearchEnginesVariables struct {
+	Engines []SearchEngineInput `json:"engines"`
+}
+
+type UpdateSearchEnginesResult struct {
+	Data struct {
+		Search struct {
+			UpdateSearchEngines struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateSearchEngines"`
+		} `json:"search"`
+	} `json:"data"`
+}
+
+type RebuildSearchIndexResult struct {
+	Data struct {
+		Search struct {
+			RebuildIndex struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"rebuildIndex"`
+		} `json:"search"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetSearchEngines() ([]SearchEngine, error) {
+
+	getSearchEnginesData := GraphQl{
+		Query: `
+{
+	search {
+		searchEngines {
+			isEnabled
+			key
+			title
+			description
+			logo
+			website
+			isAvailable
+			config {
+				key
+				value
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getSearchEngines GetSearchEngines
+	err := wikijsClient.postGraphQl(getSearchEnginesData, &getSearchEngines)
+	if err != nil {
+		return nil, err
+	}
+
+	return getSearchEngines.Data.Search.SearchEngines, nil
+}
+
+// UpdateSearchEngines updates the search engines. Wiki.js expects the full
+// list of engines, see SearchEngineInputs, and activates the enabled one.
+func (wikijsClient *WikijsClient) UpdateSearchEngines(engines []SearchEngineInput) error {
+
+	updateSearchEnginesData := GraphQl{
+		Variables: UpdateSearchEnginesVariables{Engines: engines},
+		Query: `
+mutation ($engines: [SearchEngineInput]) {
+	search {
+		updateSearchEngines(engines: $engines) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateSearchEnginesResult UpdateSearchEnginesResult
+	err := wikijsClient.postGraphQl(updateSearchEnginesData, &updateSearchEnginesResult)
+	if err != nil {
+		return err
+	}
+
+	return updateSearchEnginesResult.Data.Search.UpdateSearchEngines.ResponseResult.Err()
+}
+
+// RebuildSearchIndex rebuilds the index of the active search engine from
+// all pages.
+func (wikijsClient *WikijsClient) RebuildSearchIndex() error {
+
+	rebuildSearchIndexData := GraphQl{
+		Query: `
+mutation {
+	search {
+		rebuildIndex {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var rebuildSearchIndexResult RebuildSearchIndexResult
+	err := wikijsClient.postGraphQl(rebuildSearchIndexData, &rebuildSearchIndexResult)
+	if err != nil {
+		return err
+	}
+
+	return rebuildSearchIndexResult.Data.Search.RebuildIndex.ResponseResult.Err()
+}
+
+// SearchEngineInputs returns the engines as input for UpdateSearchEngines,
+// so that a single engine can be changed while keeping the others.
+func SearchEngineInputs(engines []SearchEngine) ([]SearchEngineInput, error) {
+	inputs := []SearchEngineInput{}
+	for _, engine := range engines {
+		properties, err := DecodeModuleConfig(engine.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := EncodeModuleConfig(ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, SearchEngineInput{
+			IsEnabled: engine.IsEnabled,
+			Key:       engine.Key,
+			Config:    config,
+		})
+	}
+	return inputs, nil
+}
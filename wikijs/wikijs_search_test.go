@@ -0,0 +1,50 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestSearchEngines() {
+
+	engines, err := suite.Client.GetSearchEngines()
+	assert.Nil(suite.T(), err)
+	original, err := SearchEngineInputs(engines)
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateSearchEngines(original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	inputs, err := SearchEngineInputs(engines)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		inputs[i].IsEnabled = inputs[i].Key == "postgres"
+		if inputs[i].Key == "postgres" {
+			inputs[i].Config, err = EncodeModuleConfig(map[string]interface{}{
+				"dictLanguage": "german",
+			})
+			assert.Nil(suite.T(), err)
+		}
+	}
+	err = suite.Client.UpdateSearchEngines(inputs)
+	assert.Nil(suite.T(), err)
+
+	engines, err = suite.Client.GetSearchEngines()
+	assert.Nil(suite.T(), err)
+	enabled := []string{}
+	for _, engine := range engines {
+		if engine.IsEnabled {
+			enabled = append(enabled, engine.Key)
+		}
+		if engine.Key != "postgres" {
+			continue
+		}
+		properties, err := DecodeModuleConfig(engine.Config)
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "german", ModuleConfigValues(properties)["dictLanguage"])
+	}
+	assert.Equal(suite.T(), []string{"postgres"}, enabled)
+
+	err = suite.Client.RebuildSearchIndex()
+	assert.Nil(suite.T(), err)
+}
@@ -0,0 +1,258 @@
+package wikijs
+
+type SiteConfig struct {
+	Host                   string   `json:"host"`
+	Title                  string   `json:"title"`
+	Description            string   `json:"description"`
+	Robots                 []string `json:"robots"`
+	AnalyticsService       string   `json:"analyticsService"`
+	AnalyticsId            string   `json:"analyticsId"`
+	Company                string   `json:"company"`
+	ContentLicense         string   `json:"contentLicense"`
+	FooterOverride         string   `json:"footerOverride"`
+	LogoUrl                string   `json:"logoUrl"`
+	PageExtensions         string   `json:"pageExtensions"`
+	AuthAutoLogin          bool     `json:"authAutoLogin"`
+	AuthEnforce2FA         bool     `json:"authEnforce2FA"`
+	AuthHideLocal          bool     `json:"authHideLocal"`
+	AuthLoginBgUrl         string   `json:"authLoginBgUrl"`
+	AuthJwtAudience        string   `json:"authJwtAudience"`
+	AuthJwtExpiration      string   `json:"authJwtExpiration"`
+	AuthJwtRenewablePeriod string   `json:"authJwtRenewablePeriod"`
+	EditFab                bool     `json:"editFab"`
+	FeaturePageRatings     bool     `json:"featurePageRatings"`
+	FeaturePageComments    bool     `json:"featurePageComments"`
+	FeaturePersonalWikis   bool     `json:"featurePersonalWikis"`
+	SecurityOpenRedirect   bool     `json:"securityOpenRedirect"`
+	SecurityIframe         bool     `json:"securityIframe"`
+	SecurityReferrerPolicy bool     `json:"securityReferrerPolicy"`
+	SecurityTrustProxy     bool     `json:"securityTrustProxy"`
+	SecuritySRI            bool     `json:"securitySRI"`
+	SecurityHSTS           bool     `json:"securityHSTS"`
+	SecurityHSTSDuration   int64    `json:"securityHSTSDuration"`
+	SecurityCSP            bool     `json:"securityCSP"`
+	SecurityCSPDirectives  string   `json:"securityCSPDirectives"`
+	UploadMaxFileSize      int64    `json:"uploadMaxFileSize"`
+	UploadMaxFiles         int64    `json:"uploadMaxFiles"`
+	UploadScanSVG          bool     `json:"uploadScanSVG"`
+	UploadForceDownload    bool     `json:"uploadForceDownload"`
+}
+
+type GetSiteConfig struct {
+	Data struct {
+		Site struct {
+			Config SiteConfig `json:"config"`
+		} `json:"site"`
+	} `json:"data"`
+}
+
+// UpdateSiteConfigVariables holds the site settings to change. Wiki.js only
+// updates the settings passed, so nil fields are left untouched.
+type UpdateSiteConfigVariables struct {
+	Host                   *string   `json:"host,omitempty"`
+	Title                  *string   `json:"title,omitempty"`
+	Description            *string   `json:"description,omitempty"`
+	Robots                 *[]string `json:"robots,omitempty"`
+	AnalyticsService       *string   `json:"analyticsService,omitempty"`
+	AnalyticsId            *string   `json:"analyticsId,omitempty"`
+	Company                *string   `json:"company,omitempty"`
+	ContentLicense         *string   `json:"contentLicense,omitempty"`
+	FooterOverride         *string   `json:"footerOverride,omitempty"`
+	LogoUrl                *string   `json:"logoUrl,omitempty"`
+	PageExtensions         *string   `json:"pageExtensions,omitempty"`
+	AuthAutoLogin          *bool     `json:"authAutoLogin,omitempty"`
+	AuthEnforce2FA         *bool     `json:"authEnforce2FA,omitempty"`
+	AuthHideLocal          *bool     `json:"authHideLocal,omitempty"`
+	AuthLoginBgUrl         *string   `json:"authLoginBgUrl,omitempty"`
+	AuthJwtAudience        *string   `json:"authJwtAudience,omitempty"`
+	AuthJwtExpiration      *string   `json:"authJwtExpiration,omitempty"`
+	AuthJwtRenewablePeriod *string   `json:"authJwtRenewablePeriod,omitempty"`
+	EditFab                *bool     `json:"editFab,omitempty"`
+	FeaturePageRatings     *bool     `json:"featurePageRatings,omitempty"`
+	FeaturePageComments    *bool     `json:"featurePageComments,omitempty"`
+	FeaturePersonalWikis   *bool     `json:"featurePersonalWikis,omitempty"`
+	SecurityOpenRedirect   *bool     `json:"securityOpenRedirect,omitempty"`
+	SecurityIframe         *bool     `json:"securityIframe,omitempty"`
+	SecurityReferrerPolicy *bool     `json:"securityReferrerPolicy,omitempty"`
+	SecurityTrustProxy     *bool     `json:"securityTrustProxy,omitempty"`
+	SecuritySRI            *bool     `json:"securitySRI,omitempty"`
+	SecurityHSTS           *bool     `json:"securityHSTS,omitempty"`
+	SecurityHSTSDuration   *int64    `json:"securityHSTSDuration,omitempty"`
+	SecurityCSP            *bool     `json:"securityCSP,omitempty"`
+	SecurityCSPDirectives  *string   `json:"securityCSPDirectives,omitempty"`
+	UploadMaxFileSize      *int64    `json:"uploadMaxFileSize,omitempty"`
+	UploadMaxFiles         *int64    `json:"uploadMaxFiles,omitempty"`
+	UploadScanSVG          *bool     `json:"uploadScanSVG,omitempty"`
+	UploadForceDownload    *bool     `json:"uploadForceDownload,omitempty"`
+}
+
+type UpdateSiteConfigResult struct {
+	Data struct {
+		Site struct {
+			UpdateConfig struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateConfig"`
+		} `json:"site"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetSiteConfig() (*SiteConfig, error) {
+
+	getSiteConfigData := GraphQl{
+		Query: `
+{
+	site {
+		config {
+			host
+			title
+			description
+			robots
+			analyticsService
+			analyticsId
+			company
+			contentLicense
+			footerOverride
+			logoUrl
+			pageExtensions
+			authAutoLogin
+			authEnforce2FA
+			authHideLocal
+			authLoginBgUrl
+			authJwtAudience
+			authJwtExpiration
+			authJwtRenewablePeriod
+			editFab
+			featurePageRatings
+			featurePageComments
+			featurePersonalWikis
+			securityOpenRedirect
+			securityIframe
+			securityReferrerPolicy
+			securityTrustProxy
+			securitySRI
+			securityHSTS
+			securityHSTSDuration
+			securityCSP
+			securityCSPDirectives
+			uploadMaxFileSize
+			uploadMaxFiles
+			uploadScanSVG
+			uploadForceDownload
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getSiteConfig GetSiteConfig
+	err := wikijsClient.postGraphQl(getSiteConfigData, &getSiteConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getSiteConfig.Data.Site.Config, nil
+}
+
+func (wikijsClient *WikijsClient) UpdateSiteConfig(variables UpdateSiteConfigVariables) error {
+
+	updateSiteConfigData := GraphQl{
+		Variables: variables,
+		Query: `
+mutation (
+	$host: String
+	$title: String
+	$description: String
+	$robots: [String]
+	$analyticsService: String
+	$analyticsId: String
+	$company: String
+	$contentLicense: String
+	$footerOverride: String
+	$logoUrl: String
+	$pageExtensions: String
+	$authAutoLogin: Boolean
+	$authEnforce2FA: Boolean
+	$authHideLocal: Boolean
+	$authLoginBgUrl: String
+	$authJwtAudience: String
+	$authJwtExpiration: String
+	$authJwtRenewablePeriod: String
+	$editFab: Boolean
+	$featurePageRatings: Boolean
+	$featurePageComments: Boolean
+	$featurePersonalWikis: Boolean
+	$securityOpenRedirect: Boolean
+	$securityIframe: Boolean
+	$securityReferrerPolicy: Boolean
+	$securityTrustProxy: Boolean
+	$securitySRI: Boolean
+	$securityHSTS: Boolean
+	$securityHSTSDuration: Int
+	$securityCSP: Boolean
+	$securityCSPDirectives: String
+	$uploadMaxFileSize: Int
+	$uploadMaxFiles: Int
+	$uploadScanSVG: Boolean
+	$uploadForceDownload: Boolean
+) {
+	site {
+		updateConfig(
+			host: $host
+			title: $title
+			description: $description
+			robots: $robots
+			analyticsService: $analyticsService
+			analyticsId: $analyticsId
+			company: $company
+			contentLicense: $contentLicense
+			footerOverride: $footerOverride
+			logoUrl: $logoUrl
+			pageExtensions: $pageExtensions
+			authAutoLogin: $authAutoLogin
+			authEnforce2FA: $authEnforce2FA
+			authHideLocal: $authHideLocal
+			authLoginBgUrl: $authLoginBgUrl
+			authJwtAudience: $authJwtAudience
+			authJwtExpiration: $authJwtExpiration
+			authJwtRenewablePeriod: $authJwtRenewablePeriod
+			editFab: $editFab
+			featurePageRatings: $featurePageRatings
+			featurePageComments: $featurePageComments
+			featurePersonalWikis: $featurePersonalWikis
+			securityOpenRedirect: $securityOpenRedirect
+			securityIframe: $securityIframe
+			securityReferrerPolicy: $securityReferrerPolicy
+			securityTrustProxy: $securityTrustProxy
+			securitySRI: $securitySRI
+			securityHSTS: $securityHSTS
+			securityHSTSDuration: $securityHSTSDuration
+			securityCSP: $securityCSP
+			securityCSPDirectives: $securityCSPDirectives
+			uploadMaxFileSize: $uploadMaxFileSize
+			uploadMaxFiles: $uploadMaxFiles
+			uploadScanSVG: $uploadScanSVG
+			uploadForceDownload: $uploadForceDownload
+		) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateSiteConfigResult UpdateSiteConfigResult
+	err := wikijsClient.postGraphQl(updateSiteConfigData, &updateSiteConfigResult)
+	if err != nil {
+		return err
+	}
+
+	return updateSiteConfigResult.Data.Site.UpdateConfig.ResponseResult.Err()
+}
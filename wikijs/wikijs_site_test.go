@@ -0,0 +1,55 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestSiteConfig() {
+
+	siteConfig, err := suite.Client.GetSiteConfig()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), siteConfig) {
+		return
+	}
+	assert.NotEmpty(suite.T(), siteConfig.Title)
+	originalDescription := siteConfig.Description
+
+	title := "Terraform Wiki"
+	robots := []string{"noindex", "nofollow"}
+	featurePageRatings := false
+	uploadMaxFiles := int64(20)
+	err = suite.Client.UpdateSiteConfig(UpdateSiteConfigVariables{
+		Title:              &title,
+		Robots:             &robots,
+		FeaturePageRatings: &featurePageRatings,
+		UploadMaxFiles:     &uploadMaxFiles,
+	})
+	assert.Nil(suite.T(), err)
+
+	siteConfig, err = suite.Client.GetSiteConfig()
+	assert.Nil(suite.T(), err)
+	if assert.NotNil(suite.T(), siteConfig) {
+		assert.Equal(suite.T(), title, siteConfig.Title)
+		assert.Equal(suite.T(), robots, siteConfig.Robots)
+		assert.Equal(suite.T(), false, siteConfig.FeaturePageRatings)
+		assert.Equal(suite.T(), uploadMaxFiles, siteConfig.UploadMaxFiles)
+		// settings not passed are left untouched
+		assert.Equal(suite.T(), originalDescription, siteConfig.Description)
+	}
+
+	// the title and page extensions are normalized
+	paddedTitle := "  " + title + " "
+	pageExtensions := " MD, html ,,"
+	err = suite.Client.UpdateSiteConfig(UpdateSiteConfigVariables{
+		Title:          &paddedTitle,
+		PageExtensions: &pageExtensions,
+	})
+	assert.Nil(suite.T(), err)
+
+	siteConfig, err = suite.Client.GetSiteConfig()
+	assert.Nil(suite.T(), err)
+	if assert.NotNil(suite.T(), siteConfig) {
+		assert.Equal(suite.T(), title, siteConfig.Title)
+		assert.Equal(suite.T(), "md,html", siteConfig.PageExtensions)
+	}
+}
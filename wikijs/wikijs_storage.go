@@ -0,0 +1,259 @@
+package wikijs
+
+// Storage status values reported by Wiki.js.
+const (
+	StorageStatusPending     = "pending"
+	StorageStatusOperational = "operational"
+	StorageStatusError       = "error"
+)
+
+type StorageTarget struct {
+	IsAvailable    bool                  `json:"isAvailable"`
+	IsEnabled      bool                  `json:"isEnabled"`
+	Key            string                `json:"key"`
+	Title          string                `json:"title"`
+	Description    string                `json:"description"`
+	Logo           string                `json:"logo"`
+	Website        string                `json:"website"`
+	SupportedModes []string              `json:"supportedModes"`
+	Mode           string                `json:"mode"`
+	HasSchedule    bool                  `json:"hasSchedule"`
+	SyncInterval   string                `json:"syncInterval"`
+	Config         []KeyValuePair        `json:"config"`
+	Actions        []StorageTargetAction `json:"actions"`
+}
+
+type StorageTargetAction struct {
+	Handler string `json:"handler"`
+	Label   string `json:"label"`
+	Hint    string `json:"hint"`
+}
+
+type GetStorageTargets struct {
+	Data struct {
+		Storage struct {
+			Targets []StorageTarget `json:"targets"`
+		} `json:"storage"`
+	} `json:"data"`
+}
+
+// StorageTargetInput holds the settings of a storage target to update. The
+// config values are encoded with EncodeModuleConfig.
+type StorageTargetInput struct {
+	IsEnabled    bool           `json:"isEnabled"`
+	Key          string         `json:"key"`
+	Mode         string         `json:"mode"`
+	SyncInterval string         `json:"syncInterval"`
+	Config       []KeyValuePair `json:"config"`
+}
+
+type UpdateStorageTargetsVariables struct {
+	Targets []StorageTargetInput `json:"targets"`
+}
+
+type UpdateStorageTargetsResult struct {
+	Data struct {
+		Storage struct {
+			UpdateTargets struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateTargets"`
+		} `json:"storage"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetStorageTargets() ([]StorageTarget, error) {
+
+	getStorageTargetsData := GraphQl{
+		Query: `
+{
+	storage {
+		targets {
+			isAvailable
+			isEnabled
+			key
+			title
+			description
+			logo
+			website
+			supportedModes
+			mode
+			hasSchedule
+			syncInterval
+			config {
+				key
+				value
+				__typename
+			}
+			actions {
+				handler
+				label
+				hint
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getStorageTargets GetStorageTargets
+	err := wikijsClient.postGraphQl(getStorageTargetsData, &getStorageTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	return getStorageTargets.Data.Storage.Targets, nil
+}
+
+// UpdateStorageTargets updates storage targets. Wiki.js expects the full
+// list of targets, see StorageTargetInputs.
+func (wikijsClient *WikijsClient) UpdateStorageTargets(targets []StorageTargetInput) error {
+
+	updateStorageTargetsData := GraphQl{
+		Variables: UpdateStorageTargetsVariables{Targets: targets},
+		Query: `
+mutation ($targets: [StorageTargetInput]!) {
+	storage {
+		updateTargets(targets: $targets) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateStorageTargetsResult UpdateStorageTargetsResult
+	err := wikijsClient.postGraphQl(updateStorageTargetsData, &updateStorageTargetsResult)
+	if err != nil {
+		return err
+	}
+
+	return updateStorageTargetsResult.Data.Storage.UpdateTargets.ResponseResult.Err()
+}
+
+// StorageTargetInputs returns the targets as input for UpdateStorageTargets,
+// so that a single target can be changed while keeping the others.
+func StorageTargetInputs(targets []StorageTarget) ([]StorageTargetInput, error) {
+	inputs := []StorageTargetInput{}
+	for _, target := range targets {
+		properties, err := DecodeModuleConfig(target.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := EncodeModuleConfig(ModuleConfigValues(properties))
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, StorageTargetInput{
+			IsEnabled:    target.IsEnabled,
+			Key:          target.Key,
+			Mode:         target.Mode,
+			SyncInterval: target.SyncInterval,
+			Config:       config,
+		})
+	}
+	return inputs, nil
+}
+
+type StorageStatus struct {
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+	LastAttempt string `json:"lastAttempt"`
+}
+
+type GetStorageStatus struct {
+	Data struct {
+		Storage struct {
+			Status []StorageStatus `json:"status"`
+		} `json:"storage"`
+	} `json:"data"`
+}
+
+type ExecuteStorageActionVariables struct {
+	TargetKey string `json:"targetKey"`
+	Handler   string `json:"handler"`
+}
+
+type ExecuteStorageActionResult struct {
+	Data struct {
+		Storage struct {
+			ExecuteAction struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"executeAction"`
+		} `json:"storage"`
+	} `json:"data"`
+}
+
+// GetStorageStatus returns the status of the enabled storage targets.
+func (wikijsClient *WikijsClient) GetStorageStatus() ([]StorageStatus, error) {
+
+	getStorageStatusData := GraphQl{
+		Query: `
+{
+	storage {
+		status {
+			key
+			title
+			status
+			message
+			lastAttempt
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getStorageStatus GetStorageStatus
+	err := wikijsClient.postGraphQl(getStorageStatusData, &getStorageStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return getStorageStatus.Data.Storage.Status, nil
+}
+
+// ExecuteStorageAction runs an action of a storage target, e.g. the sync
+// handler of git, see StorageTarget.Actions. Wiki.js only responds once the
+// action finished, so the error is that of the action. The storage status is
+// not changed by actions, only by the scheduled sync.
+func (wikijsClient *WikijsClient) ExecuteStorageAction(targetKey string, handler string) error {
+
+	executeStorageActionData := GraphQl{
+		Variables: ExecuteStorageActionVariables{TargetKey: targetKey, Handler: handler},
+		Query: `
+mutation ($targetKey: String!, $handler: String!) {
+	storage {
+		executeAction(targetKey: $targetKey, handler: $handler) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var executeStorageActionResult ExecuteStorageActionResult
+	err := wikijsClient.postGraphQl(executeStorageActionData, &executeStorageActionResult)
+	if err != nil {
+		return err
+	}
+
+	return executeStorageActionResult.Data.Storage.ExecuteAction.ResponseResult.Err()
+}
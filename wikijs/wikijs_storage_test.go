@@ -0,0 +1,111 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestStorageTargets() {
+
+	targets, err := suite.Client.GetStorageTargets()
+	assert.Nil(suite.T(), err)
+	original, err := StorageTargetInputs(targets)
+	assert.Nil(suite.T(), err)
+
+	inputs, err := StorageTargetInputs(targets)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		if inputs[i].Key != "disk" {
+			continue
+		}
+		inputs[i].IsEnabled = true
+		inputs[i].SyncInterval = "PT12H"
+		inputs[i].Config, err = EncodeModuleConfig(map[string]interface{}{
+			"path":               "/var/backups/wiki",
+			"createDailyBackups": true,
+		})
+		assert.Nil(suite.T(), err)
+	}
+	err = suite.Client.UpdateStorageTargets(inputs)
+	assert.Nil(suite.T(), err)
+
+	targets, err = suite.Client.GetStorageTargets()
+	assert.Nil(suite.T(), err)
+	found := false
+	for _, target := range targets {
+		if target.Key != "disk" {
+			continue
+		}
+		found = true
+		assert.True(suite.T(), target.IsEnabled)
+		assert.Equal(suite.T(), "PT12H", target.SyncInterval)
+		properties, err := DecodeModuleConfig(target.Config)
+		assert.Nil(suite.T(), err)
+		values := ModuleConfigValues(properties)
+		assert.Equal(suite.T(), "/var/backups/wiki", values["path"])
+		assert.Equal(suite.T(), true, values["createDailyBackups"])
+	}
+	assert.True(suite.T(), found)
+
+	err = suite.Client.UpdateStorageTargets(original)
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *WikijsApiTestSuite) TestStorageActions() {
+	if suite.Server == nil {
+		suite.T().Skip("running storage actions needs the fake Wiki.js server")
+	}
+	targets, err := suite.Client.GetStorageTargets()
+	assert.Nil(suite.T(), err)
+	original, err := StorageTargetInputs(targets)
+	assert.Nil(suite.T(), err)
+	defer func() {
+		err := suite.Client.UpdateStorageTargets(original)
+		assert.Nil(suite.T(), err)
+	}()
+
+	for _, target := range targets {
+		if target.Key == "git" {
+			assert.Contains(suite.T(), target.Actions, StorageTargetAction{
+				Handler: "sync",
+				Label:   "Force Sync",
+				Hint:    "Will trigger an immediate sync operation.",
+			})
+		}
+	}
+
+	err = suite.Client.ExecuteStorageAction("git", "sync")
+	assert.NotNil(suite.T(), err, "git is not enabled")
+
+	inputs, err := StorageTargetInputs(targets)
+	assert.Nil(suite.T(), err)
+	for i := range inputs {
+		if inputs[i].Key == "git" {
+			inputs[i].IsEnabled = true
+		}
+	}
+	err = suite.Client.UpdateStorageTargets(inputs)
+	assert.Nil(suite.T(), err)
+
+	err = suite.Client.ExecuteStorageAction("git", "unknown")
+	assert.NotNil(suite.T(), err)
+
+	// A leftover error of the scheduled sync does not fail the action.
+	suite.Server.SetStorageStatus("git", StorageStatusError, "Authentication failed")
+	err = suite.Client.ExecuteStorageAction("git", "sync")
+	assert.Nil(suite.T(), err)
+
+	suite.Server.SetStorageStatus("git", StorageStatusOperational, "")
+	suite.Server.FailStorageActions("Authentication failed")
+	defer suite.Server.FailStorageActions("")
+	err = suite.Client.ExecuteStorageAction("git", "sync")
+	assert.ErrorContains(suite.T(), err, "Authentication failed")
+
+	statuses, err := suite.Client.GetStorageStatus()
+	assert.Nil(suite.T(), err)
+	assert.Contains(suite.T(), statuses, StorageStatus{
+		Key:         "git",
+		Title:       "Git",
+		Status:      StorageStatusOperational,
+		LastAttempt: "2022-05-01T12:00:00.000Z",
+	})
+}
@@ -0,0 +1,293 @@
+package wikijs
+
+type SystemInfo struct {
+	CurrentVersion   string `json:"currentVersion"`
+	LatestVersion    string `json:"latestVersion"`
+	UpgradeCapable   bool   `json:"upgradeCapable"`
+	DbType           string `json:"dbType"`
+	DbVersion        string `json:"dbVersion"`
+	NodeVersion      string `json:"nodeVersion"`
+	Hostname         string `json:"hostname"`
+	OperatingSystem  string `json:"operatingSystem"`
+	RamTotal         string `json:"ramTotal"`
+	WorkingDirectory string `json:"workingDirectory"`
+	ConfigFile       string `json:"configFile"`
+	Telemetry        bool   `json:"telemetry"`
+	GroupsTotal      int64  `json:"groupsTotal"`
+	PagesTotal       int64  `json:"pagesTotal"`
+	UsersTotal       int64  `json:"usersTotal"`
+	TagsTotal        int64  `json:"tagsTotal"`
+}
+
+type GetSystemInfo struct {
+	Data struct {
+		System struct {
+			Info SystemInfo `json:"info"`
+		} `json:"system"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetSystemInfo() (*SystemInfo, error) {
+
+	getSystemInfoData := GraphQl{
+		Query: `
+{
+	system {
+		info {
+			currentVersion
+			latestVersion
+			upgradeCapable
+			dbType
+			dbVersion
+			nodeVersion
+			hostname
+			operatingSystem
+			ramTotal
+			workingDirectory
+			configFile
+			telemetry
+			groupsTotal
+			pagesTotal
+			usersTotal
+			tagsTotal
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getSystemInfo GetSystemInfo
+	err := wikijsClient.postGraphQl(getSystemInfoData, &getSystemInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getSystemInfo.Data.System.Info, nil
+}
+
+// SystemFlag is a debug or experimental flag, e.g. ldapdebug or sqllog.
+type SystemFlag struct {
+	Key   string `json:"key"`
+	Value bool   `json:"value"`
+}
+
+type GetSystemFlags struct {
+	Data struct {
+		System struct {
+			Flags []SystemFlag `json:"flags"`
+		} `json:"system"`
+	} `json:"data"`
+}
+
+type UpdateSystemFlagsVariables struct {
+	Flags []SystemFlag `json:"flags"`
+}
+
+type UpdateSystemFlagsResult struct {
+	Data struct {
+		System struct {
+			UpdateFlags struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"updateFlags"`
+		} `json:"system"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetSystemFlags() ([]SystemFlag, error) {
+
+	getSystemFlagsData := GraphQl{
+		Query: `
+{
+	system {
+		flags {
+			key
+			value
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getSystemFlags GetSystemFlags
+	err := wikijsClient.postGraphQl(getSystemFlagsData, &getSystemFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	return getSystemFlags.Data.System.Flags, nil
+}
+
+// UpdateSystemFlags sets the flags. Wiki.js replaces all flags, so flags
+// which are not passed are cleared.
+func (wikijsClient *WikijsClient) UpdateSystemFlags(flags []SystemFlag) error {
+
+	updateSystemFlagsData := GraphQl{
+		Variables: UpdateSystemFlagsVariables{Flags: flags},
+		Query: `
+mutation ($flags: [SystemFlagInput]!) {
+	system {
+		updateFlags(flags: $flags) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var updateSystemFlagsResult UpdateSystemFlagsResult
+	err := wikijsClient.postGraphQl(updateSystemFlagsData, &updateSystemFlagsResult)
+	if err != nil {
+		return err
+	}
+
+	return updateSystemFlagsResult.Data.System.UpdateFlags.ResponseResult.Err()
+}
+
+// SSLInfo is the HTTPS configuration of the server. Wiki.js only manages the
+// certificate if Provider is letsencrypt.
+type SSLInfo struct {
+	HttpPort        int64  `json:"httpPort"`
+	HttpRedirection bool   `json:"httpRedirection"`
+	HttpsPort       int64  `json:"httpsPort"`
+	Provider        string `json:"sslProvider"`
+	Domain          string `json:"sslDomain"`
+	Status          string `json:"sslStatus"`
+	SubscriberEmail string `json:"sslSubscriberEmail"`
+	ExpirationDate  string `json:"sslExpirationDate"`
+}
+
+type GetSSLInfo struct {
+	Data struct {
+		System struct {
+			Info SSLInfo `json:"info"`
+		} `json:"system"`
+	} `json:"data"`
+}
+
+type SetHTTPSRedirectionVariables struct {
+	Enabled bool `json:"enabled"`
+}
+
+type SetHTTPSRedirectionResult struct {
+	Data struct {
+		System struct {
+			SetHTTPSRedirection struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"setHTTPSRedirection"`
+		} `json:"system"`
+	} `json:"data"`
+}
+
+type RenewHTTPSCertificateResult struct {
+	Data struct {
+		System struct {
+			RenewHTTPSCertificate struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"renewHTTPSCertificate"`
+		} `json:"system"`
+	} `json:"data"`
+}
+
+// GetSSLInfo reads the HTTPS configuration. It is separate from
+// GetSystemInfo, as releases before 2.5 lack these fields.
+func (wikijsClient *WikijsClient) GetSSLInfo() (*SSLInfo, error) {
+
+	getSSLInfoData := GraphQl{
+		Query: `
+{
+	system {
+		info {
+			httpPort
+			httpRedirection
+			httpsPort
+			sslProvider
+			sslDomain
+			sslStatus
+			sslSubscriberEmail
+			sslExpirationDate
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getSSLInfo GetSSLInfo
+	err := wikijsClient.postGraphQl(getSSLInfoData, &getSSLInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getSSLInfo.Data.System.Info, nil
+}
+
+// SetHTTPSRedirection sets whether HTTP requests are redirected to HTTPS.
+func (wikijsClient *WikijsClient) SetHTTPSRedirection(enabled bool) error {
+	setHTTPSRedirectionData := GraphQl{
+		Variables: SetHTTPSRedirectionVariables{Enabled: enabled},
+		Query: `
+mutation ($enabled: Boolean!) {
+	system {
+		setHTTPSRedirection(enabled: $enabled) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var setHTTPSRedirectionResult SetHTTPSRedirectionResult
+	err := wikijsClient.postGraphQl(setHTTPSRedirectionData, &setHTTPSRedirectionResult)
+	if err != nil {
+		return err
+	}
+
+	return setHTTPSRedirectionResult.Data.System.SetHTTPSRedirection.ResponseResult.Err()
+}
+
+// RenewHTTPSCertificate renews the Let's Encrypt certificate. Wiki.js
+// rejects it unless the SSL provider is letsencrypt.
+func (wikijsClient *WikijsClient) RenewHTTPSCertificate() error {
+	renewHTTPSCertificateData := GraphQl{
+		Query: `
+mutation {
+	system {
+		renewHTTPSCertificate {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var renewHTTPSCertificateResult RenewHTTPSCertificateResult
+	err := wikijsClient.postGraphQl(renewHTTPSCertificateData, &renewHTTPSCertificateResult)
+	if err != nil {
+		return err
+	}
+
+	return renewHTTPSCertificateResult.Data.System.RenewHTTPSCertificate.ResponseResult.Err()
+}
@@ -0,0 +1,83 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestSystemInfo() {
+	info, err := suite.Client.GetSystemInfo()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), info) {
+		return
+	}
+	assert.NotEmpty(suite.T(), info.CurrentVersion)
+	assert.NotEmpty(suite.T(), info.DbType)
+	assert.GreaterOrEqual(suite.T(), info.UsersTotal, int64(1))
+}
+
+func (suite *WikijsApiTestSuite) TestSystemFlags() {
+	flags, err := suite.Client.GetSystemFlags()
+	assert.Nil(suite.T(), err)
+	original := map[string]bool{}
+	for _, flag := range flags {
+		original[flag.Key] = flag.Value
+	}
+	if !assert.Contains(suite.T(), original, "sqllog") {
+		return
+	}
+
+	updated := []SystemFlag{}
+	for _, flag := range flags {
+		if flag.Key == "sqllog" {
+			flag.Value = !flag.Value
+		}
+		updated = append(updated, flag)
+	}
+	err = suite.Client.UpdateSystemFlags(updated)
+	assert.Nil(suite.T(), err)
+	flags, err = suite.Client.GetSystemFlags()
+	assert.Nil(suite.T(), err)
+	assert.ElementsMatch(suite.T(), updated, flags)
+
+	err = suite.Client.UpdateSystemFlags(flagList(original))
+	assert.Nil(suite.T(), err)
+}
+
+func flagList(values map[string]bool) []SystemFlag {
+	flags := []SystemFlag{}
+	for key, value := range values {
+		flags = append(flags, SystemFlag{Key: key, Value: value})
+	}
+	return flags
+}
+
+func (suite *WikijsApiTestSuite) TestSSL() {
+	info, err := suite.Client.GetSSLInfo()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), info) {
+		return
+	}
+
+	err = suite.Client.SetHTTPSRedirection(!info.HttpRedirection)
+	assert.Nil(suite.T(), err)
+	updated, err := suite.Client.GetSSLInfo()
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), !info.HttpRedirection, updated.HttpRedirection)
+	err = suite.Client.SetHTTPSRedirection(info.HttpRedirection)
+	assert.Nil(suite.T(), err)
+
+	if suite.Server == nil {
+		return
+	}
+	err = suite.Client.RenewHTTPSCertificate()
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, suite.Server.SSLRenewals())
+	renewed, err := suite.Client.GetSSLInfo()
+	assert.Nil(suite.T(), err)
+	assert.NotEqual(suite.T(), info.ExpirationDate, renewed.ExpirationDate)
+
+	suite.Server.SetSSLProvider("custom")
+	defer suite.Server.SetSSLProvider("letsencrypt")
+	err = suite.Client.RenewHTTPSCertificate()
+	assert.NotNil(suite.T(), err)
+}
@@ -0,0 +1,108 @@
+package wikijs
+
+type ThemingConfig struct {
+	Theme       string `json:"theme"`
+	Iconset     string `json:"iconset"`
+	DarkMode    bool   `json:"darkMode"`
+	TocPosition string `json:"tocPosition"`
+	InjectCSS   string `json:"injectCSS"`
+	InjectHead  string `json:"injectHead"`
+	InjectBody  string `json:"injectBody"`
+}
+
+type GetThemingConfig struct {
+	Data struct {
+		Theming struct {
+			Config ThemingConfig `json:"config"`
+		} `json:"theming"`
+	} `json:"data"`
+}
+
+type SetThemingConfigResult struct {
+	Data struct {
+		Theming struct {
+			SetConfig struct {
+				ResponseResult ResponseResultStruct `json:"responseResult"`
+			} `json:"setConfig"`
+		} `json:"theming"`
+	} `json:"data"`
+}
+
+func (wikijsClient *WikijsClient) GetThemingConfig() (*ThemingConfig, error) {
+
+	getThemingConfigData := GraphQl{
+		Query: `
+{
+	theming {
+		config {
+			theme
+			iconset
+			darkMode
+			tocPosition
+			injectCSS
+			injectHead
+			injectBody
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var getThemingConfig GetThemingConfig
+	err := wikijsClient.postGraphQl(getThemingConfigData, &getThemingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getThemingConfig.Data.Theming.Config, nil
+}
+
+// SetThemingConfig replaces all theme settings. Wiki.js minifies the
+// injected CSS, so it reads back differently from what was set.
+func (wikijsClient *WikijsClient) SetThemingConfig(config ThemingConfig) error {
+
+	setThemingConfigData := GraphQl{
+		Variables: config,
+		Query: `
+mutation (
+	$theme: String!
+	$iconset: String!
+	$darkMode: Boolean!
+	$tocPosition: String
+	$injectCSS: String
+	$injectHead: String
+	$injectBody: String
+) {
+	theming {
+		setConfig(
+			theme: $theme
+			iconset: $iconset
+			darkMode: $darkMode
+			tocPosition: $tocPosition
+			injectCSS: $injectCSS
+			injectHead: $injectHead
+			injectBody: $injectBody
+		) {
+			responseResult {
+				succeeded
+				errorCode
+				slug
+				message
+				__typename
+			}
+			__typename
+		}
+		__typename
+	}
+}`,
+	}
+
+	var setThemingConfigResult SetThemingConfigResult
+	err := wikijsClient.postGraphQl(setThemingConfigData, &setThemingConfigResult)
+	if err != nil {
+		return err
+	}
+
+	return setThemingConfigResult.Data.Theming.SetConfig.ResponseResult.Err()
+}
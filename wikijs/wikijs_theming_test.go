@@ -0,0 +1,36 @@
+package wikijs
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *WikijsApiTestSuite) TestThemingConfig() {
+
+	original, err := suite.Client.GetThemingConfig()
+	assert.Nil(suite.T(), err)
+	if !assert.NotNil(suite.T(), original) {
+		return
+	}
+	assert.NotEmpty(suite.T(), original.Theme)
+	assert.NotEmpty(suite.T(), original.Iconset)
+
+	config := *original
+	config.DarkMode = true
+	config.TocPosition = "right"
+	config.InjectCSS = "/* brand */\n.v-main {\n  color: red;\n}\n"
+	config.InjectHead = `<meta name="robots" content="noarchive">`
+	err = suite.Client.SetThemingConfig(config)
+	assert.Nil(suite.T(), err)
+
+	themingConfig, err := suite.Client.GetThemingConfig()
+	assert.Nil(suite.T(), err)
+	if assert.NotNil(suite.T(), themingConfig) {
+		assert.Equal(suite.T(), true, themingConfig.DarkMode)
+		assert.Equal(suite.T(), "right", themingConfig.TocPosition)
+		assert.Equal(suite.T(), ".v-main{color:red}", themingConfig.InjectCSS)
+		assert.Equal(suite.T(), config.InjectHead, themingConfig.InjectHead)
+	}
+
+	err = suite.Client.SetThemingConfig(*original)
+	assert.Nil(suite.T(), err)
+}
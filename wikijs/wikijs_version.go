@@ -0,0 +1,131 @@
+package wikijs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Version is a Wiki.js release version, e.g. 2.5.300.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// MinimumVersion is the oldest Wiki.js version the client is tested against.
+var MinimumVersion = Version{Major: 2, Minor: 5}
+
+// unknownField matches the GraphQL validation error for fields missing from
+// the schema of the server.
+var unknownField = regexp.MustCompile(`^Cannot query field "([^"]+)" on type "([^"]+)"`)
+
+// ParseVersion parses a version as reported by Wiki.js, e.g. 2.5.300 or
+// v2.5.300.
+func ParseVersion(value string) (Version, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(value), "v"), ".")
+	if len(parts) != 3 {
+		return Version{}, fmt.Errorf("invalid version %q, expected major.minor.patch", value)
+	}
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return Version{}, fmt.Errorf("invalid version %q, expected major.minor.patch", value)
+		}
+		numbers[i] = number
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AtLeast returns whether v is the same as or newer than other.
+func (v Version) AtLeast(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+	return v.Patch >= other.Patch
+}
+
+// UnsupportedFieldError is returned for GraphQL fields the connected Wiki.js
+// version does not provide.
+type UnsupportedFieldError struct {
+	// Field is the GraphQL field, e.g. comments.providers. Fields of types
+	// other than the query and mutation namespaces keep the type name, e.g.
+	// SystemInfo.sslProvider.
+	Field string
+	// Version is the version of the connected server.
+	Version Version
+}
+
+func (e *UnsupportedFieldError) Error() string {
+	return fmt.Sprintf("GraphQL field %s is not available in Wiki.js %s", e.Field, e.Version)
+}
+
+// ServerVersion returns the version of the connected server, detected when the
+// client is created. ok is false if the version could not be detected.
+func (wikijsClient *WikijsClient) ServerVersion() (version Version, ok bool) {
+	if wikijsClient.version == nil {
+		return Version{}, false
+	}
+	return *wikijsClient.version, true
+}
+
+// detectVersion reads the server version. Failing to detect it only leaves
+// the version out of errors, so it is logged rather than returned.
+func (wikijsClient *WikijsClient) detectVersion() {
+	info, err := wikijsClient.GetSystemInfo()
+	if err != nil {
+		wikijsClient.logger.Warn("Unable to detect Wiki.js version", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	version, err := ParseVersion(info.CurrentVersion)
+	if err != nil {
+		wikijsClient.logger.Warn("Unable to detect Wiki.js version", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	wikijsClient.logger.Debug("Detected Wiki.js version", map[string]interface{}{"version": version.String()})
+	wikijsClient.version = &version
+}
+
+// unsupportedFieldError turns the GraphQL validation error for an unknown
+// field into an *UnsupportedFieldError naming the server version. Other
+// errors, and all errors while the version is unknown, are returned as is.
+func (wikijsClient *WikijsClient) unsupportedFieldError(err error) error {
+	if wikijsClient.version == nil {
+		return err
+	}
+	match := unknownField.FindStringSubmatch(err.Error())
+	if match == nil {
+		return err
+	}
+	return &UnsupportedFieldError{Field: fieldNamespace(match[2]) + "." + match[1], Version: *wikijsClient.version}
+}
+
+// fieldNamespace maps the GraphQL type of a namespace, e.g. CommentsQuery or
+// CommentsMutation, to the namespace used in queries, e.g. comments. Other
+// types are returned as is.
+func fieldNamespace(typeName string) string {
+	for _, suffix := range []string{"Query", "Mutation"} {
+		if strings.HasSuffix(typeName, suffix) && len(typeName) > len(suffix) {
+			namespace := strings.TrimSuffix(typeName, suffix)
+			return strings.ToLower(namespace[:1]) + namespace[1:]
+		}
+	}
+	return typeName
+}
+
+// IsUnsupportedField returns whether err is caused by a GraphQL field the
+// connected server does not provide.
+func IsUnsupportedField(err error) bool {
+	var unsupported *UnsupportedFieldError
+	return errors.As(err, &unsupported)
+}
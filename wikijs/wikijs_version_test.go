@@ -0,0 +1,82 @@
+package wikijs
+
+import (
+	"testing"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/wikijstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	version, err := ParseVersion("2.5.300")
+	assert.Nil(t, err)
+	assert.Equal(t, Version{Major: 2, Minor: 5, Patch: 300}, version)
+	assert.Equal(t, "2.5.300", version.String())
+
+	version, err = ParseVersion("v2.4.36")
+	assert.Nil(t, err)
+	assert.Equal(t, Version{Major: 2, Minor: 4, Patch: 36}, version)
+
+	for _, value := range []string{"", "dev", "2.5", "2.5.x", "2.-5.1"} {
+		_, err = ParseVersion(value)
+		assert.NotNil(t, err, value)
+	}
+
+	assert.True(t, Version{2, 5, 300}.AtLeast(Version{2, 5, 0}))
+	assert.True(t, Version{2, 5, 0}.AtLeast(Version{2, 5, 0}))
+	assert.True(t, Version{3, 0, 0}.AtLeast(Version{2, 5, 300}))
+	assert.False(t, Version{2, 4, 36}.AtLeast(Version{2, 5, 0}))
+	assert.False(t, Version{2, 5, 0}.AtLeast(Version{2, 5, 1}))
+}
+
+func TestServerVersion(t *testing.T) {
+	server := wikijstest.NewServer()
+	defer server.Close()
+
+	client, err := NewWikijsClient(server.URL, wikijstest.DefaultAdminEmail, wikijstest.DefaultAdminPassword, true, 10, "")
+	if !assert.Nil(t, err) {
+		return
+	}
+	version, ok := client.ServerVersion()
+	assert.True(t, ok)
+	assert.Equal(t, wikijstest.Version, version.String())
+
+	// Fields missing from the schema are rejected by Wiki.js with status 400
+	// and reported with the version.
+	server.SetVersion("2.4.36")
+	server.RemoveField("comments.providers")
+	server.RemoveField("navigation.updateConfig")
+	client, err = NewWikijsClient(server.URL, wikijstest.DefaultAdminEmail, wikijstest.DefaultAdminPassword, true, 10, "")
+	if !assert.Nil(t, err) {
+		return
+	}
+	_, err = client.GetCommentProviders()
+	assert.True(t, IsUnsupportedField(err))
+	assert.EqualError(t, err, "GraphQL field comments.providers is not available in Wiki.js 2.4.36")
+	err = client.UpdateNavigationConfig("TREE")
+	assert.True(t, IsUnsupportedField(err))
+	assert.EqualError(t, err, "GraphQL field navigation.updateConfig is not available in Wiki.js 2.4.36")
+
+	// Fields the server provides keep working whatever its version.
+	_, err = client.GetNavigationConfig()
+	assert.Nil(t, err)
+
+	// Without a known version, errors are returned unchanged.
+	server.SetVersion("dev")
+	client, err = NewWikijsClient(server.URL, wikijstest.DefaultAdminEmail, wikijstest.DefaultAdminPassword, true, 10, "")
+	if !assert.Nil(t, err) {
+		return
+	}
+	_, ok = client.ServerVersion()
+	assert.False(t, ok)
+	_, err = client.GetCommentProviders()
+	assert.False(t, IsUnsupportedField(err))
+	assert.EqualError(t, err, `Cannot query field "providers" on type "CommentsQuery".`)
+}
+
+func TestFieldNamespace(t *testing.T) {
+	assert.Equal(t, "comments", fieldNamespace("CommentsQuery"))
+	assert.Equal(t, "navigation", fieldNamespace("NavigationMutation"))
+	assert.Equal(t, "SystemInfo", fieldNamespace("SystemInfo"))
+	assert.Equal(t, "Query", fieldNamespace("Query"))
+}
@@ -0,0 +1,83 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (s *Server) registerAnalytics() {
+	s.analyticsProviders = []*module{
+		newModule("azureinsights", "Azure Application Insights",
+			stringProperty("instrumentationKey", "Instrumentation Key", ""),
+		),
+		newModule("elasticapm", "Elasticsearch APM RUM",
+			stringProperty("serverUrl", "APM Server URL", "http://apm.example.com:8200"),
+			stringProperty("serviceName", "Service Name", "wiki-js"),
+			stringProperty("environment", "Environment", ""),
+		),
+		newModule("fathom", "Fathom",
+			stringProperty("host", "Fathom Server Host", ""),
+			stringProperty("site", "Fathom Site ID", ""),
+		),
+		newModule("google", "Google Analytics",
+			stringProperty("propertyTrackingId", "Property Tracking ID", ""),
+		),
+		newModule("matomo", "Matomo",
+			numberProperty("siteId", "Site ID", 1),
+			stringProperty("serverHost", "Server Host", "https://example.matomo.cloud"),
+		),
+		newModule("plausible", "Plausible",
+			stringProperty("domain", "Domain", ""),
+			stringProperty("plausibleJsSrc", "Plausible.js Source", "https://plausible.io/js/plausible.js"),
+		),
+		newModule("umami", "Umami Analytics",
+			stringProperty("websiteID", "Website ID", ""),
+			stringProperty("url", "Umami Server URL", ""),
+		),
+	}
+
+	s.register("analytics.providers", false, s.getAnalyticsProviders)
+	s.register("analytics.updateProviders", false, s.updateAnalyticsProviders)
+}
+
+func (s *Server) getAnalyticsProviders(variables json.RawMessage) (interface{}, error) {
+	providers := []map[string]interface{}{}
+	for _, provider := range s.analyticsProviders {
+		providers = append(providers, map[string]interface{}{
+			"isEnabled":   provider.isEnabled,
+			"key":         provider.key,
+			"title":       provider.title,
+			"description": "",
+			"isAvailable": true,
+			"logo":        "",
+			"website":     "",
+			"config":      provider.encodedConfig(),
+		})
+	}
+	return providers, nil
+}
+
+// updateAnalyticsProviders updates the providers passed, identified by key.
+func (s *Server) updateAnalyticsProviders(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Providers []struct {
+			IsEnabled bool           `json:"isEnabled"`
+			Key       string         `json:"key"`
+			Config    []keyValuePair `json:"config"`
+		} `json:"providers"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, input := range args.Providers {
+		provider := findModule(s.analyticsProviders, input.Key)
+		if provider == nil {
+			return responseResult(fmt.Errorf("Invalid analytics provider %s", input.Key)), nil
+		}
+		if err := provider.updateConfig(input.Config); err != nil {
+			return nil, err
+		}
+		provider.isEnabled = input.IsEnabled
+	}
+	return responseResult(nil), nil
+}
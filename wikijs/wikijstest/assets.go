@@ -0,0 +1,307 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type assetFolder struct {
+	id       int64
+	parentId int64
+	slug     string
+	name     string
+}
+
+type asset struct {
+	id        int64
+	folderId  int64
+	filename  string
+	content   []byte
+	createdAt string
+	updatedAt string
+}
+
+type assetState struct {
+	folders      []*assetFolder
+	assets       []*asset
+	nextFolderId int64
+	nextAssetId  int64
+}
+
+var (
+	assetFolderSlug   = regexp.MustCompile(`^[a-z0-9_-]+$`)
+	assetFilenameChar = regexp.MustCompile(`[\s,;#]+`)
+)
+
+func (s *Server) registerAssets() {
+	s.assets.nextFolderId = 1
+	s.assets.nextAssetId = 1
+
+	s.register("assets.folders", false, s.getAssetFolders)
+	s.register("assets.createFolder", false, s.createAssetFolder)
+	s.register("assets.list", false, s.listAssets)
+	s.register("assets.renameAsset", false, s.renameAsset)
+	s.register("assets.deleteAsset", false, s.deleteAsset)
+}
+
+// AssetContent returns the content of the asset filename in the folder with
+// folderId.
+func (s *Server) AssetContent(folderId int64, filename string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.assets.assets {
+		if a.folderId == folderId && a.filename == filename {
+			return a.content, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) getAssetFolders(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		ParentFolderId int64 `json:"parentFolderId"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	folders := []map[string]interface{}{}
+	for _, folder := range s.assets.folders {
+		if folder.parentId != args.ParentFolderId {
+			continue
+		}
+		folders = append(folders, map[string]interface{}{
+			"id":   folder.id,
+			"slug": folder.slug,
+			"name": folder.name,
+		})
+	}
+	return folders, nil
+}
+
+func (s *Server) findAssetFolder(id int64) bool {
+	if id == 0 {
+		return true
+	}
+	for _, folder := range s.assets.folders {
+		if folder.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createAssetFolder(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		ParentFolderId int64  `json:"parentFolderId"`
+		Slug           string `json:"slug"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	if !s.findAssetFolder(args.ParentFolderId) {
+		return responseResult(fmt.Errorf("Invalid parent folder.")), nil
+	}
+	if !assetFolderSlug.MatchString(args.Slug) {
+		return responseResult(fmt.Errorf("Invalid folder slug.")), nil
+	}
+	for _, folder := range s.assets.folders {
+		if folder.parentId == args.ParentFolderId && folder.slug == args.Slug {
+			return responseResult(fmt.Errorf("A folder with the same name already exists.")), nil
+		}
+	}
+	// Wiki.js 2.5 ignores the name argument and names folders after their slug.
+	s.assets.folders = append(s.assets.folders, &assetFolder{
+		id:       s.assets.nextFolderId,
+		parentId: args.ParentFolderId,
+		slug:     args.Slug,
+		name:     args.Slug,
+	})
+	s.assets.nextFolderId++
+	return responseResult(nil), nil
+}
+
+func assetMime(filename string) string {
+	if mimeType := mime.TypeByExtension(path.Ext(filename)); mimeType != "" {
+		return strings.Split(mimeType, ";")[0]
+	}
+	return "application/octet-stream"
+}
+
+func (s *Server) listAssets(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		FolderId int64  `json:"folderId"`
+		Kind     string `json:"kind"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	assets := []map[string]interface{}{}
+	for _, a := range s.assets.assets {
+		if a.folderId != args.FolderId {
+			continue
+		}
+		mimeType := assetMime(a.filename)
+		kind := "BINARY"
+		if strings.HasPrefix(mimeType, "image/") {
+			kind = "IMAGE"
+		}
+		if args.Kind != "ALL" && args.Kind != kind {
+			continue
+		}
+		assets = append(assets, map[string]interface{}{
+			"id":        a.id,
+			"filename":  a.filename,
+			"ext":       path.Ext(a.filename),
+			"kind":      kind,
+			"mime":      mimeType,
+			"fileSize":  len(a.content),
+			"createdAt": a.createdAt,
+			"updatedAt": a.updatedAt,
+		})
+	}
+	return assets, nil
+}
+
+func (s *Server) findAsset(id int64) (int, error) {
+	for i, a := range s.assets.assets {
+		if a.id == id {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("This asset does not exist or is invalid.")
+}
+
+func (s *Server) renameAsset(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Id       int64  `json:"id"`
+		Filename string `json:"filename"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	i, err := s.findAsset(args.Id)
+	if err != nil {
+		return responseResult(err), nil
+	}
+	a := s.assets.assets[i]
+	if path.Ext(args.Filename) != path.Ext(a.filename) {
+		return responseResult(fmt.Errorf("An asset cannot be renamed to a different file extension.")), nil
+	}
+	if sanitizeAssetFilename(args.Filename) != args.Filename {
+		return responseResult(fmt.Errorf("An invalid filename was specified.")), nil
+	}
+	for _, other := range s.assets.assets {
+		if other.folderId == a.folderId && other.filename == args.Filename && other != a {
+			return responseResult(fmt.Errorf("An asset with the same filename in the same folder already exists.")), nil
+		}
+	}
+	a.filename = args.Filename
+	a.updatedAt = time.Now().UTC().Format(time.RFC3339)
+	return responseResult(nil), nil
+}
+
+func (s *Server) deleteAsset(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Id int64 `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	i, err := s.findAsset(args.Id)
+	if err != nil {
+		return responseResult(err), nil
+	}
+	s.assets.assets = append(s.assets.assets[:i], s.assets.assets[i+1:]...)
+	return responseResult(nil), nil
+}
+
+// sanitizeAssetFilename normalizes filenames like Wiki.js does on upload.
+func sanitizeAssetFilename(filename string) string {
+	return assetFilenameChar.ReplaceAllString(strings.ToLower(filename), "_")
+}
+
+// serveUpload handles the multipart upload of the asset manager. The first
+// mediaUpload part holds the target folder as JSON, the others the files.
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	authenticated, err := s.authenticate(r)
+	if err != nil || !authenticated {
+		http.Error(w, "You are not authorized to upload files.", http.StatusForbidden)
+		return
+	}
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	var metadata struct {
+		FolderId int64 `json:"folderId"`
+	}
+	if values := r.MultipartForm.Value["mediaUpload"]; len(values) > 0 {
+		if err := json.Unmarshal([]byte(values[0]), &metadata); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if !s.findAssetFolder(metadata.FolderId) {
+		http.Error(w, "Invalid folder.", http.StatusBadRequest)
+		return
+	}
+	files := r.MultipartForm.File["mediaUpload"]
+	if len(files) == 0 {
+		http.Error(w, "Missing upload payload.", http.StatusBadRequest)
+		return
+	}
+
+	for _, file := range files {
+		f, err := file.Open()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		content, err := ioutil.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		filename := sanitizeAssetFilename(file.Filename)
+		now := time.Now().UTC().Format(time.RFC3339)
+		var existing *asset
+		for _, a := range s.assets.assets {
+			if a.folderId == metadata.FolderId && a.filename == filename {
+				existing = a
+			}
+		}
+		if existing != nil {
+			existing.content = content
+			existing.updatedAt = now
+			continue
+		}
+		s.assets.assets = append(s.assets.assets, &asset{
+			id:        s.assets.nextAssetId,
+			folderId:  metadata.FolderId,
+			filename:  filename,
+			content:   content,
+			createdAt: now,
+			updatedAt: now,
+		})
+		s.assets.nextAssetId++
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprint(w, "ok")
+}
@@ -0,0 +1,253 @@
+package wikijstest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type apiKey struct {
+	Id         int       `json:"id"`
+	Name       string    `json:"name"`
+	KeyShort   string    `json:"keyShort"`
+	Expiration time.Time `json:"expiration"`
+	IsRevoked  bool      `json:"isRevoked"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+
+	key string
+}
+
+type keyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type authenticationStrategy struct {
+	Key          string         `json:"key"`
+	Props        []keyValuePair `json:"props"`
+	Title        string         `json:"title"`
+	Description  string         `json:"description"`
+	IsAvailable  bool           `json:"isAvailable"`
+	UseForm      bool           `json:"useForm"`
+	UsernameType string         `json:"usernameType"`
+	Logo         string         `json:"logo"`
+	Color        string         `json:"color"`
+	Website      string         `json:"website"`
+	Icon         string         `json:"icon"`
+}
+
+type activeAuthenticationStrategy struct {
+	Key              string                 `json:"key"`
+	Strategy         authenticationStrategy `json:"strategy"`
+	DisplayName      string                 `json:"displayName"`
+	Order            int                    `json:"order"`
+	IsEnabled        bool                   `json:"isEnabled"`
+	Config           []keyValuePair         `json:"config"`
+	SelfRegistration bool                   `json:"selfRegistration"`
+	DomainWhitelist  []string               `json:"domainWhitelist"`
+	AutoEnrollGroups []int                  `json:"autoEnrollGroups"`
+}
+
+type authenticationState struct {
+	apiEnabled bool
+	apiKeys    []*apiKey
+	jwts       map[string]bool
+
+	strategies       []authenticationStrategy
+	activeStrategies []activeAuthenticationStrategy
+}
+
+func (s *Server) registerAuthentication() {
+	s.auth.jwts = map[string]bool{}
+	s.auth.strategies = []authenticationStrategy{
+		{
+			Key:          "local",
+			Props:        []keyValuePair{},
+			Title:        "Local",
+			Description:  "Built-in authentication for Wiki.js",
+			IsAvailable:  true,
+			UseForm:      true,
+			UsernameType: "email",
+			Logo:         "",
+			Color:        "yellow darken-3",
+			Website:      "https://wiki.js.org",
+			Icon:         "/_assets/svg/auth-icon-local.svg",
+		},
+		{
+			Key: "keycloak",
+			Props: []keyValuePair{
+				{Key: "host", Value: `{"type":"String","title":"Host","order":1}`},
+				{Key: "realm", Value: `{"type":"String","title":"Realm","order":2}`},
+				{Key: "clientId", Value: `{"type":"String","title":"Client ID","order":3}`},
+				{Key: "clientSecret", Value: `{"type":"String","title":"Client Secret","order":4}`},
+			},
+			Title:        "Keycloak",
+			Description:  "Keycloak is an open source software product to allow single sign-on with Identity Management and Access Management.",
+			IsAvailable:  true,
+			UseForm:      false,
+			UsernameType: "",
+			Logo:         "https://static.requarks.io/logo/keycloak.svg",
+			Color:        "blue-grey darken-2",
+			Website:      "https://www.keycloak.org/",
+			Icon:         "/_assets/svg/auth-icon-keycloak.svg",
+		},
+	}
+	s.auth.activeStrategies = []activeAuthenticationStrategy{
+		{
+			Key:              "local",
+			Strategy:         s.auth.strategies[0],
+			DisplayName:      "Local",
+			Order:            0,
+			IsEnabled:        true,
+			Config:           []keyValuePair{},
+			DomainWhitelist:  []string{},
+			AutoEnrollGroups: []int{},
+		},
+	}
+
+	s.register("authentication.login", true, s.login)
+	s.register("authentication.apiState", false, s.apiState)
+	s.register("authentication.setApiState", false, s.setApiState)
+	s.register("authentication.apiKeys", false, s.apiKeys)
+	s.register("authentication.createApiKey", false, s.createApiKey)
+	s.register("authentication.revokeApiKey", false, s.revokeApiKey)
+	s.register("authentication.strategies", false, s.strategies)
+	s.register("authentication.activeStrategies", false, s.activeStrategies)
+}
+
+// authenticate reports whether the request carries a valid API key or JWT.
+// Like Wiki.js, an API key sent while the API is disabled or after it was
+// revoked fails the whole request.
+func (s *Server) authenticate(r *http.Request) (bool, error) {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		token := strings.TrimPrefix(header, "Bearer ")
+		if !s.auth.apiEnabled {
+			return false, fmt.Errorf("API is disabled. You must enable it from the Administration Area first.")
+		}
+		for _, key := range s.auth.apiKeys {
+			if key.key == token && !key.IsRevoked {
+				return true, nil
+			}
+		}
+		return false, fmt.Errorf("API Key is invalid or was revoked.")
+	}
+
+	if cookie, err := r.Cookie("jwt"); err == nil {
+		return s.auth.jwts[cookie.Value], nil
+	}
+	return false, nil
+}
+
+func randomToken(length int) string {
+	b := make([]byte, length/2)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *Server) login(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Strategy string `json:"strategy"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+
+	if !s.setupDone || args.Strategy != "local" || args.Username != s.adminEmail || args.Password != s.adminPassword {
+		return nil, fmt.Errorf("Invalid email / username or password.")
+	}
+
+	jwt := randomToken(64)
+	s.auth.jwts[jwt] = true
+
+	result := responseResult(nil)
+	result["jwt"] = jwt
+	result["mustChangePwd"] = false
+	result["mustProvideTFA"] = false
+	result["mustSetupTFA"] = false
+	result["continuationToken"] = nil
+	result["redirect"] = "/"
+	result["tfaQRImage"] = nil
+	return result, nil
+}
+
+func (s *Server) apiState(variables json.RawMessage) (interface{}, error) {
+	return s.auth.apiEnabled, nil
+}
+
+func (s *Server) setApiState(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	s.auth.apiEnabled = args.Enabled
+	return responseResult(nil), nil
+}
+
+func (s *Server) apiKeys(variables json.RawMessage) (interface{}, error) {
+	return s.auth.apiKeys, nil
+}
+
+func (s *Server) createApiKey(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Name       string `json:"name"`
+		Expiration string `json:"expiration"`
+		FullAccess bool   `json:"fullAccess"`
+		Group      *int   `json:"group"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	if !args.FullAccess && args.Group == nil {
+		return responseResult(fmt.Errorf("A group is required for keys without full access.")), nil
+	}
+
+	now := time.Now().UTC()
+	key := &apiKey{
+		Id:         len(s.auth.apiKeys) + 1,
+		Name:       args.Name,
+		Expiration: now.AddDate(1, 0, 0),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		key:        randomToken(64),
+	}
+	key.KeyShort = "..." + key.key[len(key.key)-20:]
+	s.auth.apiKeys = append(s.auth.apiKeys, key)
+
+	result := responseResult(nil)
+	result["key"] = key.key
+	return result, nil
+}
+
+func (s *Server) revokeApiKey(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	// Wiki.js does not complain about unknown ids.
+	for _, key := range s.auth.apiKeys {
+		if key.Id == args.Id {
+			key.IsRevoked = true
+			key.UpdatedAt = time.Now().UTC()
+		}
+	}
+	return responseResult(nil), nil
+}
+
+func (s *Server) strategies(variables json.RawMessage) (interface{}, error) {
+	return s.auth.strategies, nil
+}
+
+func (s *Server) activeStrategies(variables json.RawMessage) (interface{}, error) {
+	return s.auth.activeStrategies, nil
+}
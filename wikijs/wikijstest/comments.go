@@ -0,0 +1,67 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (s *Server) registerComments() {
+	s.commentProviders = []*module{
+		newModule("commento", "Commento",
+			stringProperty("instanceUrl", "Instance URL", "https://cdn.commento.io"),
+		),
+		newModule("default", "Default",
+			sensitiveProperty("akismet", "Akismet API Key"),
+			numberProperty("minDelay", "Minimum Delay", 30),
+		),
+		newModule("disqus", "Disqus",
+			stringProperty("accountName", "Shortname", ""),
+		),
+	}
+	findModule(s.commentProviders, "default").isEnabled = true
+
+	s.register("comments.providers", false, s.getCommentProviders)
+	s.register("comments.updateProviders", false, s.updateCommentProviders)
+}
+
+func (s *Server) getCommentProviders(variables json.RawMessage) (interface{}, error) {
+	providers := []map[string]interface{}{}
+	for _, provider := range s.commentProviders {
+		providers = append(providers, map[string]interface{}{
+			"isEnabled":   provider.isEnabled,
+			"key":         provider.key,
+			"title":       provider.title,
+			"description": "",
+			"logo":        "",
+			"website":     "",
+			"isAvailable": true,
+			"config":      provider.encodedConfig(),
+		})
+	}
+	return providers, nil
+}
+
+// updateCommentProviders updates the providers passed, identified by key.
+func (s *Server) updateCommentProviders(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Providers []struct {
+			IsEnabled bool           `json:"isEnabled"`
+			Key       string         `json:"key"`
+			Config    []keyValuePair `json:"config"`
+		} `json:"providers"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, input := range args.Providers {
+		provider := findModule(s.commentProviders, input.Key)
+		if provider == nil {
+			return responseResult(fmt.Errorf("Invalid comment provider %s", input.Key)), nil
+		}
+		if err := provider.updateConfig(input.Config); err != nil {
+			return nil, err
+		}
+		provider.isEnabled = input.IsEnabled
+	}
+	return responseResult(nil), nil
+}
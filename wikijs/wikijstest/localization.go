@@ -0,0 +1,158 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type locale struct {
+	Availability int64   `json:"availability"`
+	Code         string  `json:"code"`
+	CreatedAt    string  `json:"createdAt"`
+	InstallDate  *string `json:"installDate"`
+	IsInstalled  bool    `json:"isInstalled"`
+	IsRTL        bool    `json:"isRTL"`
+	Name         string  `json:"name"`
+	NativeName   string  `json:"nativeName"`
+	UpdatedAt    string  `json:"updatedAt"`
+
+	// pendingPolls is the number of locale queries before a downloaded
+	// locale shows up as installed.
+	pendingPolls int
+}
+
+type localizationConfig struct {
+	Locale      string   `json:"locale"`
+	AutoUpdate  bool     `json:"autoUpdate"`
+	Namespacing bool     `json:"namespacing"`
+	Namespaces  []string `json:"namespaces"`
+}
+
+type localizationState struct {
+	locales      []*locale
+	config       localizationConfig
+	installDelay int
+}
+
+const localeTimestamp = "2022-05-01T00:00:00.000Z"
+
+func (s *Server) registerLocalization() {
+	available := []struct {
+		code, name, nativeName string
+		availability           int64
+		isRTL                  bool
+	}{
+		{"ar", "Arabic", "العربية", 71, true},
+		{"de", "German", "Deutsch", 100, false},
+		{"en", "English", "English", 100, false},
+		{"es", "Spanish", "Español", 96, false},
+		{"fr", "French", "Français", 100, false},
+		{"ja", "Japanese", "日本語", 89, false},
+		{"zh", "Chinese Simplified", "中文", 99, false},
+	}
+	for _, a := range available {
+		s.localization.locales = append(s.localization.locales, &locale{
+			Availability: a.availability,
+			Code:         a.code,
+			CreatedAt:    localeTimestamp,
+			IsRTL:        a.isRTL,
+			Name:         a.name,
+			NativeName:   a.nativeName,
+			UpdatedAt:    localeTimestamp,
+		})
+	}
+	s.installLocale(s.findLocale("en"))
+	s.localization.config = localizationConfig{
+		Locale:     "en",
+		AutoUpdate: true,
+		Namespaces: []string{},
+	}
+
+	s.register("localization.locales", false, s.getLocales)
+	s.register("localization.config", false, s.getLocalizationConfig)
+	s.register("localization.downloadLocale", false, s.downloadLocale)
+	s.register("localization.updateLocale", false, s.updateLocale)
+}
+
+// DelayLocaleInstall makes downloaded locales show up as installed only
+// after polls queries of the locales, like a slow download.
+func (s *Server) DelayLocaleInstall(polls int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.localization.installDelay = polls
+}
+
+func (s *Server) findLocale(code string) *locale {
+	for _, l := range s.localization.locales {
+		if l.Code == code {
+			return l
+		}
+	}
+	return nil
+}
+
+func (s *Server) installLocale(l *locale) {
+	installDate := localeTimestamp
+	l.InstallDate = &installDate
+	l.IsInstalled = true
+}
+
+func (s *Server) getLocales(variables json.RawMessage) (interface{}, error) {
+	for _, l := range s.localization.locales {
+		if l.pendingPolls > 0 {
+			l.pendingPolls--
+			if l.pendingPolls == 0 {
+				s.installLocale(l)
+			}
+		}
+	}
+	return s.localization.locales, nil
+}
+
+func (s *Server) getLocalizationConfig(variables json.RawMessage) (interface{}, error) {
+	return s.localization.config, nil
+}
+
+func (s *Server) downloadLocale(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Locale string `json:"locale"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	l := s.findLocale(args.Locale)
+	if l == nil {
+		return responseResult(fmt.Errorf("Invalid locale or namespace")), nil
+	}
+	if l.IsInstalled {
+		return responseResult(nil), nil
+	}
+	if s.localization.installDelay > 0 {
+		l.pendingPolls = s.localization.installDelay
+	} else {
+		s.installLocale(l)
+	}
+	return responseResult(nil), nil
+}
+
+// updateLocale adds the site locale to the namespaces, like Wiki.js.
+func (s *Server) updateLocale(variables json.RawMessage) (interface{}, error) {
+	var args localizationConfig
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	if args.Locale == "" || args.Namespaces == nil {
+		return nil, fmt.Errorf("Variables \"$locale\" and \"$namespaces\" of required type were not provided.")
+	}
+	if args.Namespacing {
+		found := false
+		for _, namespace := range args.Namespaces {
+			found = found || namespace == args.Locale
+		}
+		if !found {
+			args.Namespaces = append(args.Namespaces, args.Locale)
+		}
+	}
+	s.localization.config = args
+	return responseResult(nil), nil
+}
@@ -0,0 +1,102 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type logger struct {
+	*module
+	level string
+}
+
+var logLevels = []string{"error", "warn", "info", "verbose", "debug", "silly"}
+
+func (s *Server) registerLogging() {
+	s.loggers = []*logger{
+		{module: newModule("bugsnag", "Bugsnag",
+			sensitiveProperty("key", "Bugsnag API Key"),
+		)},
+		{module: newModule("disk", "Disk",
+			stringProperty("path", "Log Directory", "./data/logs"),
+		)},
+		{module: newModule("loggly", "Loggly",
+			sensitiveProperty("token", "Token"),
+			stringProperty("subdomain", "Subdomain", ""),
+		)},
+		{module: newModule("papertrail", "Papertrail",
+			stringProperty("host", "Host", ""),
+			numberProperty("port", "Port", 0),
+		)},
+		{module: newModule("sentry", "Sentry",
+			stringProperty("key", "DSN", ""),
+		)},
+		{module: newModule("syslog", "Syslog",
+			stringProperty("host", "Host", "localhost"),
+			numberProperty("port", "Port", 514),
+			enumProperty("protocol", "Protocol", "udp4", "tcp4", "udp4", "unix"),
+		)},
+	}
+	for _, l := range s.loggers {
+		l.level = "warn"
+	}
+
+	s.register("logging.loggers", false, s.getLoggers)
+	s.register("logging.updateLoggers", false, s.updateLoggers)
+}
+
+func (s *Server) getLoggers(variables json.RawMessage) (interface{}, error) {
+	loggers := []map[string]interface{}{}
+	for _, l := range s.loggers {
+		loggers = append(loggers, map[string]interface{}{
+			"isEnabled":   l.isEnabled,
+			"key":         l.key,
+			"title":       l.title,
+			"description": "",
+			"logo":        "",
+			"website":     "",
+			"level":       l.level,
+			"config":      l.encodedConfig(),
+		})
+	}
+	return loggers, nil
+}
+
+// updateLoggers updates the loggers passed, identified by key.
+func (s *Server) updateLoggers(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Loggers []struct {
+			IsEnabled bool           `json:"isEnabled"`
+			Key       string         `json:"key"`
+			Level     string         `json:"level"`
+			Config    []keyValuePair `json:"config"`
+		} `json:"loggers"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, input := range args.Loggers {
+		var l *logger
+		for _, candidate := range s.loggers {
+			if candidate.key == input.Key {
+				l = candidate
+			}
+		}
+		if l == nil {
+			return responseResult(fmt.Errorf("Invalid logger %s", input.Key)), nil
+		}
+		valid := false
+		for _, level := range logLevels {
+			valid = valid || level == input.Level
+		}
+		if !valid {
+			return responseResult(fmt.Errorf("Invalid log level %s", input.Level)), nil
+		}
+		if err := l.updateConfig(input.Config); err != nil {
+			return nil, err
+		}
+		l.isEnabled = input.IsEnabled
+		l.level = input.Level
+	}
+	return responseResult(nil), nil
+}
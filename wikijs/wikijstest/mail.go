@@ -0,0 +1,90 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+)
+
+type mailConfig struct {
+	SenderName      string `json:"senderName"`
+	SenderEmail     string `json:"senderEmail"`
+	Host            string `json:"host"`
+	Port            int64  `json:"port"`
+	Name            string `json:"name"`
+	Secure          bool   `json:"secure"`
+	VerifySSL       bool   `json:"verifySSL"`
+	User            string `json:"user"`
+	Pass            string `json:"pass"`
+	UseDKIM         bool   `json:"useDKIM"`
+	DKIMDomainName  string `json:"dkimDomainName"`
+	DKIMKeySelector string `json:"dkimKeySelector"`
+	DKIMPrivateKey  string `json:"dkimPrivateKey"`
+}
+
+const maskedMailPassword = "********"
+
+func (s *Server) registerMail() {
+	s.mail = mailConfig{
+		SenderName: "Wiki.js",
+		Port:       465,
+		Secure:     true,
+		VerifySSL:  true,
+	}
+
+	s.register("mail.config", false, s.getMailConfig)
+	s.register("mail.updateConfig", false, s.updateMailConfig)
+	s.register("mail.sendTest", false, s.sendTestMail)
+}
+
+// getMailConfig returns the config with the password masked like Wiki.js.
+func (s *Server) getMailConfig(variables json.RawMessage) (interface{}, error) {
+	config := s.mail
+	if config.Pass != "" {
+		config.Pass = maskedMailPassword
+	}
+	return config, nil
+}
+
+func (s *Server) updateMailConfig(variables json.RawMessage) (interface{}, error) {
+	var config mailConfig
+	if err := json.Unmarshal(variables, &config); err != nil {
+		return nil, err
+	}
+	if config.Pass == maskedMailPassword {
+		config.Pass = s.mail.Pass
+	}
+	s.mail = config
+	return responseResult(nil), nil
+}
+
+// sendTestMail delivers a test mail to the configured SMTP server, e.g. an
+// SMTPServer. TLS is not supported.
+func (s *Server) sendTestMail(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		RecipientEmail string `json:"recipientEmail"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	if len(args.RecipientEmail) < 6 {
+		return responseResult(fmt.Errorf("Invalid email address.")), nil
+	}
+	if s.mail.Host == "" {
+		return responseResult(fmt.Errorf("Mail is not setup yet")), nil
+	}
+
+	var auth smtp.Auth
+	if s.mail.User != "" {
+		auth = smtp.PlainAuth("", s.mail.User, s.mail.Pass, s.mail.Host)
+	}
+	message := fmt.Sprintf("From: %s <%s>\r\nTo: %s\r\nSubject: A test email from your wiki\r\n\r\nThis is a test email sent from your wiki.\r\n",
+		s.mail.SenderName, s.mail.SenderEmail, args.RecipientEmail)
+	addr := net.JoinHostPort(s.mail.Host, strconv.FormatInt(s.mail.Port, 10))
+	if err := smtp.SendMail(addr, auth, s.mail.SenderEmail, []string{args.RecipientEmail}, []byte(message)); err != nil {
+		return responseResult(err), nil
+	}
+	return responseResult(nil), nil
+}
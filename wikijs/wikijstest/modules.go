@@ -0,0 +1,107 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// moduleProperty describes a config property of a module, e.g. the branch
+// of the git storage target.
+type moduleProperty struct {
+	Key       string        `json:"-"`
+	Type      string        `json:"type"`
+	Title     string        `json:"title"`
+	Default   interface{}   `json:"default"`
+	Hint      string        `json:"hint,omitempty"`
+	Sensitive bool          `json:"sensitive,omitempty"`
+	Enum      []interface{} `json:"enum,omitempty"`
+	Order     int           `json:"order"`
+}
+
+// module is a configurable module like a storage target, search engine or
+// renderer.
+type module struct {
+	key       string
+	title     string
+	isEnabled bool
+	props     []moduleProperty
+	config    map[string]interface{}
+}
+
+func newModule(key string, title string, props ...moduleProperty) *module {
+	m := &module{
+		key:    key,
+		title:  title,
+		props:  props,
+		config: map[string]interface{}{},
+	}
+	for i := range m.props {
+		m.props[i].Order = i + 1
+		m.config[m.props[i].Key] = m.props[i].Default
+	}
+	return m
+}
+
+func stringProperty(key string, title string, def string) moduleProperty {
+	return moduleProperty{Key: key, Type: "String", Title: title, Default: def}
+}
+
+func sensitiveProperty(key string, title string) moduleProperty {
+	return moduleProperty{Key: key, Type: "String", Title: title, Default: "", Sensitive: true}
+}
+
+func booleanProperty(key string, title string, def bool) moduleProperty {
+	return moduleProperty{Key: key, Type: "Boolean", Title: title, Default: def}
+}
+
+func numberProperty(key string, title string, def float64) moduleProperty {
+	return moduleProperty{Key: key, Type: "Number", Title: title, Default: def}
+}
+
+func enumProperty(key string, title string, def string, values ...string) moduleProperty {
+	property := stringProperty(key, title, def)
+	for _, value := range values {
+		property.Enum = append(property.Enum, value)
+	}
+	return property
+}
+
+// encodedConfig returns the config as read from Wiki.js: each value is the
+// JSON encoded property description together with the value.
+func (m *module) encodedConfig() []keyValuePair {
+	pairs := []keyValuePair{}
+	for _, prop := range m.props {
+		encoded := map[string]interface{}{}
+		raw, _ := json.Marshal(prop)
+		_ = json.Unmarshal(raw, &encoded)
+		encoded["value"] = m.config[prop.Key]
+		value, _ := json.Marshal(encoded)
+		pairs = append(pairs, keyValuePair{Key: prop.Key, Value: string(value)})
+	}
+	return pairs
+}
+
+// updateConfig sets the config from values encoded as {"v": value}.
+func (m *module) updateConfig(pairs []keyValuePair) error {
+	config := map[string]interface{}{}
+	for _, pair := range pairs {
+		var value struct {
+			V interface{} `json:"v"`
+		}
+		if err := json.Unmarshal([]byte(pair.Value), &value); err != nil {
+			return fmt.Errorf("invalid config %s of %s: %w", pair.Key, m.key, err)
+		}
+		config[pair.Key] = value.V
+	}
+	m.config = config
+	return nil
+}
+
+func findModule(modules []*module, key string) *module {
+	for _, m := range modules {
+		if m.key == key {
+			return m
+		}
+	}
+	return nil
+}
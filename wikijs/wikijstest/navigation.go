@@ -0,0 +1,88 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type navigationItem struct {
+	Id               string  `json:"id"`
+	Kind             string  `json:"kind"`
+	Label            string  `json:"label"`
+	Icon             string  `json:"icon"`
+	TargetType       string  `json:"targetType"`
+	Target           string  `json:"target"`
+	VisibilityMode   string  `json:"visibilityMode"`
+	VisibilityGroups []int64 `json:"visibilityGroups"`
+}
+
+type navigationTree struct {
+	Locale string           `json:"locale"`
+	Items  []navigationItem `json:"items"`
+}
+
+type navigationState struct {
+	mode string
+	tree []navigationTree
+}
+
+var navigationModes = map[string]bool{"NONE": true, "TREE": true, "MIXED": true, "STATIC": true}
+
+func (s *Server) registerNavigation() {
+	s.navigation = navigationState{
+		mode: "MIXED",
+		tree: []navigationTree{{
+			Locale: "en",
+			Items: []navigationItem{{
+				Id:             "home",
+				Kind:           "link",
+				Label:          "Home",
+				Icon:           "mdi-home",
+				TargetType:     "home",
+				Target:         "/",
+				VisibilityMode: "all",
+			}},
+		}},
+	}
+
+	s.register("navigation.tree", false, s.getNavigationTree)
+	s.register("navigation.updateTree", false, s.updateNavigationTree)
+	s.register("navigation.config", false, s.getNavigationConfig)
+	s.register("navigation.updateConfig", false, s.updateNavigationConfig)
+}
+
+func (s *Server) getNavigationTree(variables json.RawMessage) (interface{}, error) {
+	return s.navigation.tree, nil
+}
+
+func (s *Server) updateNavigationTree(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Tree []navigationTree `json:"tree"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	if args.Tree == nil {
+		return nil, fmt.Errorf("Variable \"$tree\" of required type \"[NavigationTreeInput]!\" was not provided.")
+	}
+	s.navigation.tree = args.Tree
+	return responseResult(nil), nil
+}
+
+func (s *Server) getNavigationConfig(variables json.RawMessage) (interface{}, error) {
+	return map[string]interface{}{"mode": s.navigation.mode}, nil
+}
+
+func (s *Server) updateNavigationConfig(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Mode string `json:"mode"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	if !navigationModes[args.Mode] {
+		return nil, fmt.Errorf("Variable \"$mode\" got invalid value %q.", args.Mode)
+	}
+	s.navigation.mode = args.Mode
+	return responseResult(nil), nil
+}
@@ -0,0 +1,218 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type pageTag struct {
+	id        int64
+	tag       string
+	title     string
+	createdAt string
+	updatedAt string
+}
+
+type page struct {
+	id     int64
+	path   string
+	locale string
+	title  string
+	tags   []string
+}
+
+type pagesState struct {
+	tags       []*pageTag
+	pages      []*page
+	nextTagId  int64
+	nextPageId int64
+}
+
+func (s *Server) registerPages() {
+	s.pages.nextTagId = 1
+	s.pages.nextPageId = 1
+
+	s.register("pages.tags", false, s.getPageTags)
+	s.register("pages.searchTags", false, s.searchPageTags)
+	s.register("pages.updateTag", false, s.updatePageTag)
+	s.register("pages.deleteTag", false, s.deletePageTag)
+	s.register("pages.list", false, s.listPages)
+}
+
+// AddPage adds a page with tags, creating the tags which do not exist yet
+// like Wiki.js does when a page is saved.
+func (s *Server) AddPage(path, title string, tags ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range tags {
+		if s.findPageTag(tag) == nil {
+			now := time.Now().UTC().Format(time.RFC3339)
+			s.pages.tags = append(s.pages.tags, &pageTag{
+				id:        s.pages.nextTagId,
+				tag:       tag,
+				title:     tag,
+				createdAt: now,
+				updatedAt: now,
+			})
+			s.pages.nextTagId++
+		}
+	}
+	s.pages.pages = append(s.pages.pages, &page{
+		id:     s.pages.nextPageId,
+		path:   path,
+		locale: "en",
+		title:  title,
+		tags:   tags,
+	})
+	s.pages.nextPageId++
+}
+
+func (s *Server) findPageTag(tag string) *pageTag {
+	for _, t := range s.pages.tags {
+		if t.tag == tag {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) getPageTags(variables json.RawMessage) (interface{}, error) {
+	tags := []map[string]interface{}{}
+	for _, t := range s.pages.tags {
+		tags = append(tags, map[string]interface{}{
+			"id":        t.id,
+			"tag":       t.tag,
+			"title":     t.title,
+			"createdAt": t.createdAt,
+			"updatedAt": t.updatedAt,
+		})
+	}
+	return tags, nil
+}
+
+// searchPageTags matches tags containing the query, ignoring case.
+func (s *Server) searchPageTags(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	tags := []string{}
+	for _, t := range s.pages.tags {
+		if strings.Contains(strings.ToLower(t.tag), strings.ToLower(args.Query)) {
+			tags = append(tags, t.tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+func (s *Server) updatePageTag(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Id    int64  `json:"id"`
+		Tag   string `json:"tag"`
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	tag := strings.ToLower(strings.TrimSpace(args.Tag))
+	if tag == "" {
+		return responseResult(fmt.Errorf("Tag cannot be empty.")), nil
+	}
+	for _, t := range s.pages.tags {
+		if t.id != args.Id {
+			continue
+		}
+		for _, p := range s.pages.pages {
+			for i := range p.tags {
+				if p.tags[i] == t.tag {
+					p.tags[i] = tag
+				}
+			}
+		}
+		t.tag = tag
+		t.title = strings.TrimSpace(args.Title)
+		t.updatedAt = time.Now().UTC().Format(time.RFC3339)
+		return responseResult(nil), nil
+	}
+	return responseResult(fmt.Errorf("This tag does not exist.")), nil
+}
+
+func (s *Server) deletePageTag(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Id int64 `json:"id"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for i, t := range s.pages.tags {
+		if t.id != args.Id {
+			continue
+		}
+		for _, p := range s.pages.pages {
+			tags := []string{}
+			for _, tag := range p.tags {
+				if tag != t.tag {
+					tags = append(tags, tag)
+				}
+			}
+			p.tags = tags
+		}
+		s.pages.tags = append(s.pages.tags[:i], s.pages.tags[i+1:]...)
+		return responseResult(nil), nil
+	}
+	return responseResult(fmt.Errorf("This tag does not exist.")), nil
+}
+
+// listPages returns the pages having all of the requested tags.
+func (s *Server) listPages(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Tags []string `json:"tags"`
+	}
+	if len(variables) > 0 {
+		if err := json.Unmarshal(variables, &args); err != nil {
+			return nil, err
+		}
+	}
+	pages := []map[string]interface{}{}
+	for _, p := range s.pages.pages {
+		matches := true
+		for _, tag := range args.Tags {
+			found := false
+			for _, pageTag := range p.tags {
+				found = found || pageTag == tag
+			}
+			matches = matches && found
+		}
+		if !matches {
+			continue
+		}
+		pages = append(pages, map[string]interface{}{
+			"id":     p.id,
+			"path":   p.path,
+			"locale": p.locale,
+			"title":  p.title,
+			"tags":   p.tags,
+		})
+	}
+	return pages, nil
+}
+
+// DeletePage deletes the page at path. Its tags are kept like in Wiki.js.
+func (s *Server) DeletePage(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, p := range s.pages.pages {
+		if p.path == path {
+			s.pages.pages = append(s.pages.pages[:i], s.pages.pages[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package wikijstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/camjjack/terraform-provider-wikijs/wikijs/internal/secrets"
+)
+
+// RecorderMode selects whether a Recorder talks to a real Wiki.js or serves
+// responses from a fixture file.
+type RecorderMode int
+
+const (
+	// ModeReplay serves responses from the fixture file without any network access.
+	ModeReplay RecorderMode = iota
+	// ModeRecord forwards requests to Wiki.js and records the exchanges.
+	ModeRecord
+)
+
+// Redacted replaces secrets in recorded fixtures.
+const Redacted = "REDACTED"
+
+// sensitiveObjectKeys are keys which are only scrubbed inside the named
+// object, as the same key is harmless elsewhere (e.g. a strategy key). All
+// other secrets are found by the rules of the secrets package, which the
+// client log uses as well.
+var sensitiveObjectKeys = map[string]string{
+	"createApiKey": "key",
+}
+
+// recordedHeaders are the only response headers kept in fixtures. Request
+// headers are never recorded, as they carry the JWT cookie and API key.
+var recordedHeaders = []string{"Content-Type", "Location"}
+
+// Body is a recorded request or response body. JSON bodies are kept as JSON
+// so that fixtures stay readable and can be scrubbed.
+type Body struct {
+	Json interface{} `json:"json,omitempty"`
+	Text string      `json:"text,omitempty"`
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   Body   `json:"body"`
+}
+
+type RecordedResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       Body              `json:"body"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Recorder is an http.RoundTripper which records Wiki.js exchanges to a JSON
+// fixture file, or replays them from one. Replayed requests must arrive in
+// the recorded order and match its method, path and GraphQL operation.
+type Recorder struct {
+	mode  RecorderMode
+	path  string
+	inner http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	next         int
+}
+
+// NewRecorder creates a Recorder for the fixture at path. In ModeRecord,
+// requests are sent through inner, or http.DefaultTransport when nil, and
+// Save must be called to write the fixture. In ModeReplay the fixture must
+// already exist.
+func NewRecorder(path string, mode RecorderMode, inner http.RoundTripper) (*Recorder, error) {
+	recorder := &Recorder{
+		mode:  mode,
+		path:  path,
+		inner: inner,
+	}
+
+	switch mode {
+	case ModeRecord:
+		if recorder.inner == nil {
+			recorder.inner = http.DefaultTransport
+		}
+	case ModeReplay:
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture: %v", err)
+		}
+		if err := json.Unmarshal(content, &recorder.interactions); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %s: %v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown recorder mode: %d", mode)
+	}
+
+	return recorder, nil
+}
+
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if request.Body != nil {
+		var err error
+		requestBody, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(request, requestBody)
+	}
+	return r.record(request, requestBody)
+}
+
+func (r *Recorder) record(request *http.Request, requestBody []byte) (*http.Response, error) {
+	outgoing := request.Clone(request.Context())
+	outgoing.Body = ioutil.NopCloser(bytes.NewReader(requestBody))
+	outgoing.ContentLength = int64(len(requestBody))
+
+	response, err := r.inner.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: request.Method,
+			Path:   request.URL.Path,
+			Body:   newBody(requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    map[string]string{},
+			Body:       newBody(responseBody),
+		},
+	}
+	for _, header := range recordedHeaders {
+		if value := response.Header.Get(header); value != "" {
+			interaction.Response.Headers[header] = value
+		}
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return response, nil
+}
+
+func (r *Recorder) replay(request *http.Request, requestBody []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.next >= len(r.interactions) {
+		return nil, fmt.Errorf("fixture %s has no interaction left for %s %s", r.path, request.Method, request.URL.Path)
+	}
+	interaction := r.interactions[r.next]
+
+	if interaction.Request.Method != request.Method || interaction.Request.Path != request.URL.Path {
+		return nil, fmt.Errorf("fixture %s interaction %d: expected %s %s, got %s %s", r.path, r.next,
+			interaction.Request.Method, interaction.Request.Path, request.Method, request.URL.Path)
+	}
+	if expected, actual := operationOf(interaction.Request.Body.bytes()), operationOf(requestBody); expected != actual {
+		return nil, fmt.Errorf("fixture %s interaction %d: expected operation %s, got %s", r.path, r.next, expected, actual)
+	}
+	r.next++
+
+	response := &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Request:       request,
+		ContentLength: -1,
+	}
+	for header, value := range interaction.Response.Headers {
+		response.Header.Set(header, value)
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(interaction.Response.Body.bytes()))
+
+	return response, nil
+}
+
+// Save writes the recorded interactions to the fixture file with secrets
+// scrubbed. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.interactions {
+		scrub(r.interactions[i].Request.Body.Json)
+		scrub(r.interactions[i].Response.Body.Json)
+	}
+
+	content, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(content, '\n'), 0644)
+}
+
+// Remaining returns the number of interactions not yet replayed.
+func (r *Recorder) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.interactions) - r.next
+}
+
+func newBody(content []byte) Body {
+	if len(content) == 0 {
+		return Body{}
+	}
+	var value interface{}
+	if err := json.Unmarshal(content, &value); err == nil {
+		return Body{Json: value}
+	}
+	return Body{Text: string(content)}
+}
+
+func (b Body) bytes() []byte {
+	if b.Json != nil {
+		content, _ := json.Marshal(b.Json)
+		return content
+	}
+	return []byte(b.Text)
+}
+
+// operationOf identifies the GraphQL operation in a request body, or returns
+// an empty string for other requests.
+func operationOf(body []byte) string {
+	var request struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil || request.Query == "" {
+		return ""
+	}
+	namespace, fields, err := parseQuery(request.Query)
+	if err != nil {
+		return ""
+	}
+	return namespace + "." + strings.Join(fields, ",")
+}
+
+// scrub replaces secrets in a decoded JSON value in place.
+func scrub(value interface{}) {
+	secrets.Mask(value, redact)
+	scrubObjectKeys(value, "")
+}
+
+// redact returns the scrubbed form of a secret. Module config values are JSON
+// encoded objects holding the value in "v" when sent and in "value" when read,
+// which keep their other fields so that replayed configs can still be decoded.
+func redact(secret interface{}) interface{} {
+	if encoded, ok := secret.(string); ok {
+		var config map[string]interface{}
+		if err := json.Unmarshal([]byte(encoded), &config); err == nil {
+			for _, field := range []string{"v", "value"} {
+				if _, ok := config[field]; ok {
+					config[field] = Redacted
+					content, _ := json.Marshal(config)
+					return string(content)
+				}
+			}
+		}
+	}
+	return Redacted
+}
+
+// scrubObjectKeys replaces the sensitiveObjectKeys in a decoded JSON value in
+// place. parent is the key under which value is found.
+func scrubObjectKeys(value interface{}, parent string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if sensitiveObjectKeys[parent] == key {
+				if child != nil {
+					v[key] = Redacted
+				}
+				continue
+			}
+			scrubObjectKeys(child, key)
+		}
+	case []interface{}:
+		for _, child := range v {
+			scrubObjectKeys(child, parent)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type renderer struct {
+	*module
+	dependsOn string
+	input     string
+	output    string
+}
+
+func (s *Server) registerRendering() {
+	markdown := func(m *module, dependsOn string) *renderer {
+		m.isEnabled = true
+		return &renderer{module: m, dependsOn: dependsOn, input: "markdown", output: "html"}
+	}
+	html := func(m *module, dependsOn string) *renderer {
+		m.isEnabled = true
+		return &renderer{module: m, dependsOn: dependsOn, input: "html", output: "html"}
+	}
+	s.renderers = []*renderer{
+		html(newModule("htmlCore", "Core",
+			booleanProperty("absoluteLinks", "Treat relative links as root absolute", false),
+			booleanProperty("openExternalLinkNewTab", "Open external links in a new tab", false),
+			enumProperty("relAttributeExternalLink", "Value of the \"rel\" attribute for external links", "noreferrer",
+				"noreferrer", "nofollow", "noopener", "noreferrer nofollow", "noreferrer noopener", "nofollow noopener", "noreferrer nofollow noopener"),
+		), ""),
+		html(newModule("htmlSecurity", "Security",
+			booleanProperty("safeHTML", "Sanitize HTML", true),
+			booleanProperty("allowDrawIoUnsafe", "Allow potentially unsafe draw.io content", true),
+			booleanProperty("allowIFrames", "Allow iframes", false),
+		), "htmlCore"),
+		html(newModule("htmlMermaid", "Mermaid"), "htmlCore"),
+		html(newModule("htmlTwemoji", "Twemoji"), "htmlCore"),
+		markdown(newModule("markdownCore", "Core",
+			booleanProperty("allowHTML", "Allow HTML", true),
+			booleanProperty("linkify", "Automatically convert links", true),
+			enumProperty("linebreaks", "Automatically convert line breaks", "true", "true", "false"),
+			booleanProperty("underline", "Underline Emphasis", false),
+			booleanProperty("typographer", "Typographer", false),
+			enumProperty("quotes", "Quotes style", "English", "Chinese", "English", "French", "German", "Greek", "Japanese", "Hungarian", "Polish", "Portuguese", "Russian", "Spanish", "Swedish"),
+		), "htmlCore"),
+		markdown(newModule("markdownEmoji", "Emoji"), "markdownCore"),
+		markdown(newModule("markdownKatex", "Katex",
+			booleanProperty("useInline", "Inline TeX", true),
+			booleanProperty("useBlocks", "TeX Blocks", true),
+		), "markdownCore"),
+		markdown(newModule("markdownKroki", "Kroki",
+			stringProperty("server", "Kroki Server", "https://kroki.io"),
+			stringProperty("openMarker", "Open Marker", "```kroki"),
+			stringProperty("closeMarker", "Close Marker", "```"),
+		), "markdownCore"),
+		markdown(newModule("markdownMathjax", "Mathjax",
+			booleanProperty("useInline", "Inline TeX", true),
+			booleanProperty("useBlocks", "TeX Blocks", true),
+		), "markdownCore"),
+		markdown(newModule("markdownPlantuml", "PlantUML",
+			stringProperty("server", "PlantUML Server", "https://plantuml.requarks.io"),
+			stringProperty("openMarker", "Open Marker", "```plantuml"),
+			stringProperty("closeMarker", "Close Marker", "```"),
+			enumProperty("imageFormat", "Image Format", "svg", "png", "svg"),
+		), "markdownCore"),
+	}
+	for _, key := range []string{"markdownKroki", "markdownMathjax"} {
+		s.findRenderer(key).isEnabled = false
+	}
+
+	s.register("rendering.renderers", false, s.getRenderers)
+	s.register("rendering.updateRenderers", false, s.updateRenderers)
+}
+
+func (s *Server) findRenderer(key string) *renderer {
+	for _, r := range s.renderers {
+		if r.key == key {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *Server) getRenderers(variables json.RawMessage) (interface{}, error) {
+	renderers := []map[string]interface{}{}
+	for _, renderer := range s.renderers {
+		var dependsOn interface{}
+		if renderer.dependsOn != "" {
+			dependsOn = renderer.dependsOn
+		}
+		renderers = append(renderers, map[string]interface{}{
+			"isEnabled":   renderer.isEnabled,
+			"key":         renderer.key,
+			"title":       renderer.title,
+			"description": "",
+			"icon":        "",
+			"dependsOn":   dependsOn,
+			"input":       renderer.input,
+			"output":      renderer.output,
+			"config":      renderer.encodedConfig(),
+		})
+	}
+	return renderers, nil
+}
+
+// updateRenderers updates the renderers passed, identified by key.
+func (s *Server) updateRenderers(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Renderers []struct {
+			IsEnabled bool           `json:"isEnabled"`
+			Key       string         `json:"key"`
+			Config    []keyValuePair `json:"config"`
+		} `json:"renderers"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, input := range args.Renderers {
+		r := s.findRenderer(input.Key)
+		if r == nil {
+			return responseResult(fmt.Errorf("Invalid renderer %s", input.Key)), nil
+		}
+		if err := r.updateConfig(input.Config); err != nil {
+			return nil, err
+		}
+		r.isEnabled = input.IsEnabled
+	}
+	return responseResult(nil), nil
+}
@@ -0,0 +1,115 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type searchState struct {
+	engines  []*module
+	rebuilds int
+}
+
+func (s *Server) registerSearch() {
+	s.search.engines = []*module{
+		newModule("db", "Database - Basic"),
+		newModule("postgres", "Database - PostgreSQL",
+			enumProperty("dictLanguage", "Dictionary Language", "english",
+				"simple", "danish", "dutch", "english", "finnish", "french", "german", "spanish", "swedish"),
+		),
+		newModule("elasticsearch", "Elasticsearch",
+			enumProperty("apiVersion", "Elasticsearch Version", "6.x", "7.x", "6.x"),
+			stringProperty("hosts", "Host(s)", ""),
+			booleanProperty("verifyTLSCertificate", "Verify TLS Certificate", true),
+			stringProperty("tlsCertPath", "TLS Certificate Path", ""),
+			stringProperty("indexName", "Index Name", "wiki"),
+			stringProperty("analyzer", "Analyzer", "simple"),
+			booleanProperty("sniffOnStart", "Sniff on start", false),
+			numberProperty("sniffInterval", "Sniff Interval", 0),
+		),
+		newModule("algolia", "Algolia",
+			stringProperty("appId", "App ID", ""),
+			sensitiveProperty("apiKey", "Admin API Key"),
+			stringProperty("indexName", "Index Name", "wiki"),
+		),
+		newModule("aws", "AWS CloudSearch",
+			stringProperty("domain", "Search Domain", ""),
+			stringProperty("endpoint", "Document Endpoint", ""),
+			stringProperty("region", "Region", "us-east-1"),
+			stringProperty("accessKeyId", "Access Key ID", ""),
+			sensitiveProperty("secretAccessKey", "Secret Access Key"),
+			enumProperty("AnalysisSchemeLang", "Analysis Scheme Language", "en", "ar", "de", "en", "es", "fr", "ja", "zh-Hans"),
+		),
+		newModule("azure", "Azure Search",
+			stringProperty("serviceName", "Service Name", ""),
+			sensitiveProperty("adminKey", "Admin API Key"),
+			stringProperty("indexName", "Index Name", "wiki"),
+		),
+		newModule("manticore", "Manticore Search"),
+		newModule("solr", "Apache Solr",
+			stringProperty("host", "Host", "solr"),
+			numberProperty("port", "Port", 8983),
+			stringProperty("core", "Core", "wiki"),
+			enumProperty("protocol", "Protocol", "http", "http", "https"),
+		),
+	}
+	s.search.engines[0].isEnabled = true
+
+	s.register("search.searchEngines", false, s.getSearchEngines)
+	s.register("search.updateSearchEngines", false, s.updateSearchEngines)
+	s.register("search.rebuildIndex", false, s.rebuildSearchIndex)
+}
+
+// SearchIndexRebuilds returns how often the search index was rebuilt.
+func (s *Server) SearchIndexRebuilds() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.search.rebuilds
+}
+
+func (s *Server) getSearchEngines(variables json.RawMessage) (interface{}, error) {
+	engines := []map[string]interface{}{}
+	for _, engine := range s.search.engines {
+		engines = append(engines, map[string]interface{}{
+			"isEnabled":   engine.isEnabled,
+			"key":         engine.key,
+			"title":       engine.title,
+			"description": "",
+			"logo":        "",
+			"website":     "",
+			"isAvailable": true,
+			"config":      engine.encodedConfig(),
+		})
+	}
+	return engines, nil
+}
+
+// updateSearchEngines updates the engines passed, identified by key.
+func (s *Server) updateSearchEngines(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Engines []struct {
+			IsEnabled bool           `json:"isEnabled"`
+			Key       string         `json:"key"`
+			Config    []keyValuePair `json:"config"`
+		} `json:"engines"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, input := range args.Engines {
+		engine := findModule(s.search.engines, input.Key)
+		if engine == nil {
+			return responseResult(fmt.Errorf("Invalid search engine %s", input.Key)), nil
+		}
+		if err := engine.updateConfig(input.Config); err != nil {
+			return nil, err
+		}
+		engine.isEnabled = input.IsEnabled
+	}
+	return responseResult(nil), nil
+}
+
+func (s *Server) rebuildSearchIndex(variables json.RawMessage) (interface{}, error) {
+	s.search.rebuilds++
+	return responseResult(nil), nil
+}
@@ -0,0 +1,384 @@
+// Package wikijstest provides an in-process fake of the Wiki.js HTTP and
+// GraphQL API, so that the client and provider can be tested without a
+// running Wiki.js instance.
+package wikijstest
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	DefaultAdminEmail    = "admin@wiki.example.local"
+	DefaultAdminPassword = "wikijsrocks"
+)
+
+// resolver handles a single GraphQL field below a namespace, e.g.
+// authentication.login. It receives the raw request variables and returns
+// the value placed at data.<namespace>.<field> in the response.
+type resolver func(variables json.RawMessage) (interface{}, error)
+
+type operation struct {
+	// public operations may be called without a valid JWT or API key.
+	public  bool
+	resolve resolver
+}
+
+// Server is a fake Wiki.js instance backed by in-memory state.
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	operations map[string]operation
+
+	setupDone     bool
+	adminEmail    string
+	adminPassword string
+	siteUrl       string
+
+	auth               authenticationState
+	siteConfig         map[string]interface{}
+	theming            themingConfig
+	navigation         navigationState
+	localization       localizationState
+	storageTargets     []*storageTarget
+	storage            storageState
+	search             searchState
+	renderers          []*renderer
+	mail               mailConfig
+	commentProviders   []*module
+	analyticsProviders []*module
+	loggers            []*logger
+	assets             assetState
+	pages              pagesState
+	system             systemState
+}
+
+// NewServer starts a fake Wiki.js instance which still requires the initial
+// setup to be finalized. The caller must call Close when done.
+func NewServer() *Server {
+	s := newServer()
+	s.Start()
+	return s
+}
+
+// NewTLSServer starts a fake Wiki.js instance serving HTTPS with a self-signed
+// certificate, see httptest.Server.Certificate. If clientCAs is not nil,
+// clients must present a certificate signed by one of them.
+func NewTLSServer(clientCAs *x509.CertPool) *Server {
+	s := newServer()
+	if clientCAs != nil {
+		s.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+	}
+	s.StartTLS()
+	return s
+}
+
+func newServer() *Server {
+	s := &Server{
+		operations: map[string]operation{},
+	}
+	s.registerAuthentication()
+	s.registerSite()
+	s.registerTheming()
+	s.registerNavigation()
+	s.registerLocalization()
+	s.registerStorage()
+	s.registerSearch()
+	s.registerRendering()
+	s.registerMail()
+	s.registerComments()
+	s.registerAnalytics()
+	s.registerLogging()
+	s.registerAssets()
+	s.registerPages()
+	s.registerSystem()
+
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *Server) register(name string, public bool, resolve resolver) {
+	s.operations[name] = operation{public: public, resolve: resolve}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/":
+		s.serveIndex(w, r)
+	case "/finalize":
+		s.serveFinalize(w, r)
+	case "/graphql":
+		s.serveGraphQl(w, r)
+	case "/u":
+		s.serveUpload(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) serveIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if !s.setupDone {
+		fmt.Fprint(w, "<!DOCTYPE html><html><head><title>Wiki.js Setup</title></head><body><setup></setup></body></html>")
+		return
+	}
+	fmt.Fprint(w, "<!DOCTYPE html><html><head><title>Wiki.js</title></head><body><page></page></body></html>")
+}
+
+func (s *Server) serveFinalize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var finalize struct {
+		AdminEmail           string `json:"adminEmail"`
+		AdminPassword        string `json:"adminPassword"`
+		AdminPasswordConfirm string `json:"adminPasswordConfirm"`
+		SiteUrl              string `json:"siteUrl"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&finalize); err != nil {
+		writeJson(w, map[string]interface{}{"ok": false, "error": err.Error()})
+		return
+	}
+
+	switch {
+	case s.setupDone:
+		writeJson(w, map[string]interface{}{"ok": false, "error": "Setup has already been completed."})
+	case finalize.AdminPassword != finalize.AdminPasswordConfirm:
+		writeJson(w, map[string]interface{}{"ok": false, "error": "Passwords do not match."})
+	default:
+		s.setupDone = true
+		s.adminEmail = finalize.AdminEmail
+		s.adminPassword = finalize.AdminPassword
+		s.siteUrl = finalize.SiteUrl
+		writeJson(w, map[string]interface{}{"ok": true})
+	}
+}
+
+type graphQlError struct {
+	Message string `json:"message"`
+}
+
+func (s *Server) serveGraphQl(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	authenticated, err := s.authenticate(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var request struct {
+		Variables json.RawMessage `json:"variables"`
+		Query     string          `json:"query"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	namespace, fields, err := parseQuery(request.Query)
+	if err != nil {
+		writeGraphQlErrors(w, []graphQlError{{Message: err.Error()}})
+		return
+	}
+
+	// Like Apollo, documents failing validation are rejected as a whole with
+	// status 400 and without data.
+	var errors []graphQlError
+	for _, field := range fields {
+		if _, ok := s.operations[namespace+"."+field]; !ok {
+			errors = append(errors, graphQlError{Message: fmt.Sprintf("Cannot query field \"%s\" on type \"%s\".", field, namespaceType(namespace, request.Query))})
+		}
+	}
+	if len(errors) > 0 {
+		writeGraphQlErrors(w, errors)
+		return
+	}
+
+	results := map[string]interface{}{}
+	for _, field := range fields {
+		op := s.operations[namespace+"."+field]
+		if !op.public && !authenticated {
+			errors = append(errors, graphQlError{Message: "Forbidden"})
+			results[field] = nil
+			continue
+		}
+		result, err := op.resolve(request.Variables)
+		if err != nil {
+			errors = append(errors, graphQlError{Message: err.Error()})
+			results[field] = nil
+			continue
+		}
+		results[field] = result
+	}
+
+	response := map[string]interface{}{
+		"data": map[string]interface{}{namespace: results},
+	}
+	if len(errors) > 0 {
+		response["errors"] = errors
+	}
+	writeJson(w, response)
+}
+
+// writeGraphQlErrors answers a document failing validation.
+func writeGraphQlErrors(w http.ResponseWriter, errors []graphQlError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": errors})
+}
+
+// namespaceType returns the GraphQL type of a namespace, e.g. CommentsQuery
+// or CommentsMutation for comments.
+func namespaceType(namespace, query string) string {
+	operation := "Query"
+	if strings.HasPrefix(strings.TrimSpace(query), "mutation") {
+		operation = "Mutation"
+	}
+	return strings.ToUpper(namespace[:1]) + namespace[1:] + operation
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// responseResult builds the responseResult object returned by mutations.
+func responseResult(err error) map[string]interface{} {
+	if err != nil {
+		return map[string]interface{}{
+			"responseResult": map[string]interface{}{
+				"succeeded": false,
+				"errorCode": 1,
+				"slug":      "error",
+				"message":   err.Error(),
+			},
+		}
+	}
+	return map[string]interface{}{
+		"responseResult": map[string]interface{}{
+			"succeeded": true,
+			"errorCode": 0,
+			"slug":      "ok",
+			"message":   "Operation succeeded.",
+		},
+	}
+}
+
+// parseQuery extracts the namespace and the fields selected below it from a
+// GraphQL document such as:
+//
+//	mutation ($enabled: Boolean!) { authentication { setApiState(enabled: $enabled) { ... } } }
+//
+// Only the shape of documents sent by the wikijs client is supported.
+func parseQuery(query string) (string, []string, error) {
+	rest := query
+	// skip the operation type and variable definitions
+	depth := 0
+	start := -1
+	for i, c := range rest {
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			depth--
+		} else if c == '{' && depth == 0 {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return "", nil, fmt.Errorf("Syntax Error: Expected {")
+	}
+	rest = rest[start+1:]
+
+	namespace, rest := readName(rest)
+	if namespace == "" {
+		return "", nil, fmt.Errorf("Syntax Error: Expected Name")
+	}
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "{") {
+		return "", nil, fmt.Errorf("Field \"%s\" must have a selection of subfields.", namespace)
+	}
+	rest = rest[1:]
+
+	var fields []string
+	for {
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			return "", nil, fmt.Errorf("Syntax Error: Expected }")
+		}
+		if rest[0] == '}' {
+			break
+		}
+		var field string
+		field, rest = readName(rest)
+		if field == "" {
+			return "", nil, fmt.Errorf("Syntax Error: Unexpected %q", rest[0])
+		}
+		if field != "__typename" {
+			fields = append(fields, field)
+		}
+		rest = strings.TrimSpace(rest)
+		rest = skipGroup(rest, '(', ')')
+		rest = strings.TrimSpace(rest)
+		rest = skipGroup(rest, '{', '}')
+	}
+
+	if len(fields) == 0 {
+		return "", nil, fmt.Errorf("Field \"%s\" must have a selection of subfields.", namespace)
+	}
+	return namespace, fields, nil
+}
+
+func readName(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) {
+		c := s[end]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (end > 0 && c >= '0' && c <= '9') {
+			end++
+			continue
+		}
+		break
+	}
+	return s[:end], s[end:]
+}
+
+// skipGroup skips a balanced group delimited by open and close if s starts
+// with open.
+func skipGroup(s string, open, close byte) string {
+	if s == "" || s[0] != open {
+		return s
+	}
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return s[i+1:]
+			}
+		}
+	}
+	return ""
+}
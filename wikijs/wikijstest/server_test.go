@@ -0,0 +1,57 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	namespace, fields, err := parseQuery(`
+mutation ($enabled: Boolean!) {
+	authentication {
+	    setApiState(enabled: $enabled) {
+			responseResult {
+			    succeeded
+		        __typename
+	        }
+	        __typename
+	    }
+	    __typename
+	}
+}`)
+	assert.Nil(t, err)
+	assert.Equal(t, "authentication", namespace)
+	assert.Equal(t, []string{"setApiState"}, fields)
+
+	namespace, fields, err = parseQuery("{ navigation { tree { locale } config { mode } } }")
+	assert.Nil(t, err)
+	assert.Equal(t, "navigation", namespace)
+	assert.Equal(t, []string{"tree", "config"}, fields)
+
+	_, _, err = parseQuery("{ authentication }")
+	assert.NotNil(t, err)
+
+	_, _, err = parseQuery("query")
+	assert.NotNil(t, err)
+}
+
+func TestUnknownField(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.RemoveField("comments.providers")
+
+	response, err := http.Post(server.URL+"/graphql", "application/json", strings.NewReader(`{"query":"{ comments { providers { key } } }"}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	var body map[string]interface{}
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&body))
+	assert.NotContains(t, body, "data")
+	assert.Equal(t, []interface{}{map[string]interface{}{"message": `Cannot query field "providers" on type "CommentsQuery".`}}, body["errors"])
+}
@@ -0,0 +1,95 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// defaultSiteConfig mirrors the site settings of a fresh Wiki.js 2.5 install.
+func defaultSiteConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"host":                   "",
+		"title":                  "Wiki.js",
+		"description":            "",
+		"robots":                 []interface{}{"index", "follow"},
+		"analyticsService":       "",
+		"analyticsId":            "",
+		"company":                "",
+		"contentLicense":         "",
+		"footerOverride":         "",
+		"logoUrl":                "https://static.requarks.io/logo/wikijs-butterfly.svg",
+		"pageExtensions":         "md,html,txt",
+		"authAutoLogin":          false,
+		"authEnforce2FA":         false,
+		"authHideLocal":          false,
+		"authLoginBgUrl":         "",
+		"authJwtAudience":        "urn:wiki.js",
+		"authJwtExpiration":      "30m",
+		"authJwtRenewablePeriod": "14d",
+		"editFab":                true,
+		"featurePageRatings":     true,
+		"featurePageComments":    true,
+		"featurePersonalWikis":   true,
+		"securityOpenRedirect":   true,
+		"securityIframe":         true,
+		"securityReferrerPolicy": true,
+		"securityTrustProxy":     true,
+		"securitySRI":            true,
+		"securityHSTS":           false,
+		"securityHSTSDuration":   300,
+		"securityCSP":            false,
+		"securityCSPDirectives":  "",
+		"uploadMaxFileSize":      5242880,
+		"uploadMaxFiles":         10,
+		"uploadScanSVG":          true,
+		"uploadForceDownload":    true,
+	}
+}
+
+func (s *Server) registerSite() {
+	s.siteConfig = defaultSiteConfig()
+
+	s.register("site.config", false, s.getSiteConfig)
+	s.register("site.updateConfig", false, s.updateSiteConfig)
+}
+
+func (s *Server) getSiteConfig(variables json.RawMessage) (interface{}, error) {
+	config := map[string]interface{}{}
+	for key, value := range s.siteConfig {
+		config[key] = value
+	}
+	config["host"] = s.siteUrl
+	return config, nil
+}
+
+// updateSiteConfig only changes the settings passed and normalizes the host,
+// title and page extensions, like Wiki.js.
+func (s *Server) updateSiteConfig(variables json.RawMessage) (interface{}, error) {
+	var args map[string]interface{}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for key, value := range args {
+		if _, ok := s.siteConfig[key]; !ok {
+			continue
+		}
+		text, _ := value.(string)
+		switch key {
+		case "host":
+			s.siteUrl = strings.TrimRight(strings.TrimSpace(text), "/")
+			continue
+		case "title":
+			value = strings.TrimSpace(text)
+		case "pageExtensions":
+			pageExtensions := []string{}
+			for _, pageExtension := range strings.Split(strings.TrimSpace(text), ",") {
+				if pageExtension = strings.ToLower(strings.TrimSpace(pageExtension)); pageExtension != "" {
+					pageExtensions = append(pageExtensions, pageExtension)
+				}
+			}
+			value = strings.Join(pageExtensions, ",")
+		}
+		s.siteConfig[key] = value
+	}
+	return responseResult(nil), nil
+}
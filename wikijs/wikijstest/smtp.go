@@ -0,0 +1,204 @@
+package wikijstest
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// SMTPMessage is a mail received by an SMTPServer.
+type SMTPMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// SMTPServer is a minimal SMTP server standing in for a mail relay. It
+// accepts mails without TLS and, if credentials are given, requires AUTH
+// PLAIN with them. Point the mail config of the fake Wiki.js at Host and
+// Port to test sending mails.
+type SMTPServer struct {
+	Host string
+	Port int64
+
+	listener net.Listener
+	username string
+	password string
+
+	mu       sync.Mutex
+	messages []SMTPMessage
+	wg       sync.WaitGroup
+}
+
+// NewSMTPServer starts an SMTP server on a local port. If username is not
+// empty, clients must authenticate with username and password. The caller
+// must call Close when done.
+func NewSMTPServer(username string, password string) *SMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("wikijstest: failed to listen on a port: %v", err))
+	}
+	addr := listener.Addr().(*net.TCPAddr)
+	s := &SMTPServer{
+		Host:     addr.IP.String(),
+		Port:     int64(addr.Port),
+		listener: listener,
+		username: username,
+		password: password,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// Close stops the server and waits for open connections to finish.
+func (s *SMTPServer) Close() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+// Messages returns the mails received so far.
+func (s *SMTPServer) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMTPMessage{}, s.messages...)
+}
+
+func (s *SMTPServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *SMTPServer) handle(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	reply := func(format string, args ...interface{}) {
+		fmt.Fprintf(conn, format+"\r\n", args...)
+	}
+	readLine := func() (string, bool) {
+		line, err := reader.ReadString('\n')
+		return strings.TrimRight(line, "\r\n"), err == nil
+	}
+
+	authenticated := s.username == ""
+	var message *SMTPMessage
+	reply("220 localhost ESMTP wikijstest")
+	for {
+		line, ok := readLine()
+		if !ok {
+			return
+		}
+		verb, arg, _ := cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			if s.username != "" {
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			} else {
+				reply("250 localhost")
+			}
+		case "HELO":
+			reply("250 localhost")
+		case "AUTH":
+			mechanism, response, _ := cut(arg, " ")
+			if !strings.EqualFold(mechanism, "PLAIN") {
+				reply("504 5.5.4 Unrecognized authentication type")
+				continue
+			}
+			if response == "" {
+				reply("334 ")
+				if response, ok = readLine(); !ok {
+					return
+				}
+			}
+			decoded, _ := base64.StdEncoding.DecodeString(response)
+			parts := strings.Split(string(decoded), "\x00")
+			if len(parts) == 3 && parts[1] == s.username && parts[2] == s.password {
+				authenticated = true
+				reply("235 2.7.0 Authentication successful")
+			} else {
+				reply("535 5.7.8 Authentication credentials invalid")
+			}
+		case "MAIL":
+			if !authenticated {
+				reply("530 5.7.0 Authentication required")
+				continue
+			}
+			message = &SMTPMessage{From: smtpAddress(arg)}
+			reply("250 2.1.0 OK")
+		case "RCPT":
+			if message == nil {
+				reply("503 5.5.1 Bad sequence of commands")
+				continue
+			}
+			message.To = append(message.To, smtpAddress(arg))
+			reply("250 2.1.5 OK")
+		case "DATA":
+			if message == nil || len(message.To) == 0 {
+				reply("503 5.5.1 Bad sequence of commands")
+				continue
+			}
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				line, ok := readLine()
+				if !ok {
+					return
+				}
+				if line == "." {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+				data.WriteString("\r\n")
+			}
+			message.Data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, *message)
+			s.mu.Unlock()
+			message = nil
+			reply("250 2.0.0 OK")
+		case "RSET":
+			message = nil
+			reply("250 2.0.0 OK")
+		case "NOOP":
+			reply("250 2.0.0 OK")
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			reply("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+// smtpAddress returns the address of a MAIL FROM or RCPT TO argument like
+// FROM:<wiki@example.com>.
+func smtpAddress(arg string) string {
+	_, address, _ := cut(arg, ":")
+	address = strings.TrimSpace(address)
+	if i := strings.Index(address, " "); i >= 0 {
+		address = address[:i]
+	}
+	return strings.Trim(address, "<>")
+}
+
+// cut splits s around the first sep, returning s and "" if sep is missing.
+func cut(s string, sep string) (string, string, bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,263 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type storageTarget struct {
+	*module
+	supportedModes []string
+	mode           string
+	hasSchedule    bool
+	syncInterval   string
+	actions        []storageAction
+
+	// status, message and lastAttempt are reported by storage.status while
+	// the target is enabled. Like in Wiki.js, they are only changed by the
+	// scheduled sync, see SetStorageStatus, not by actions.
+	status      string
+	message     string
+	lastAttempt string
+}
+
+type storageAction struct {
+	Handler string `json:"handler"`
+	Label   string `json:"label"`
+	Hint    string `json:"hint"`
+}
+
+type storageState struct {
+	actionError string
+	executed    []string
+}
+
+// storageTimestamp is the lastAttempt reported by SetStorageStatus.
+const storageTimestamp = "2022-05-01T12:00:00.000Z"
+
+func (s *Server) registerStorage() {
+	s.storageTargets = []*storageTarget{
+		{
+			module: newModule("disk", "Local File System",
+				stringProperty("path", "Path", ""),
+				booleanProperty("createDailyBackups", "Create Daily Backups", false),
+			),
+			supportedModes: []string{"push"},
+			mode:           "push",
+			hasSchedule:    true,
+			syncInterval:   "P1D",
+			actions: []storageAction{
+				{Handler: "dump", Label: "Dump all content to disk", Hint: "Output all content from the DB to the local disk."},
+				{Handler: "backup", Label: "Create Backup", Hint: "Will create a manual backup archive at this point in time."},
+				{Handler: "importAll", Label: "Import Everything", Hint: "Will import all content currently in the local disk folder."},
+			},
+		},
+		{
+			module: newModule("git", "Git",
+				enumProperty("authType", "Authentication Type", "ssh", "basic", "ssh"),
+				stringProperty("repoUrl", "Repository URI", ""),
+				stringProperty("branch", "Branch", "master"),
+				enumProperty("sshPrivateKeyMode", "SSH Private Key Mode", "path", "path", "contents"),
+				stringProperty("sshPrivateKeyPath", "A - SSH Private Key Path", ""),
+				sensitiveProperty("sshPrivateKeyContent", "B - SSH Private Key Contents"),
+				booleanProperty("verifySSL", "Verify SSL Certificate", true),
+				stringProperty("basicUsername", "Username", ""),
+				sensitiveProperty("basicPassword", "Password / PAT"),
+				stringProperty("defaultEmail", "Default Author Email", "name@company.com"),
+				stringProperty("defaultName", "Default Author Name", "John Smith"),
+				stringProperty("localRepoPath", "Local Repository Path", "./data/repo"),
+				stringProperty("gitBinaryPath", "Git Binary Path", ""),
+			),
+			supportedModes: []string{"sync", "push", "pull"},
+			mode:           "sync",
+			hasSchedule:    true,
+			syncInterval:   "PT5M",
+			actions: []storageAction{
+				{Handler: "syncUntracked", Label: "Add Untracked Changes", Hint: "Output all content from the DB to the local Git repository."},
+				{Handler: "sync", Label: "Force Sync", Hint: "Will trigger an immediate sync operation."},
+				{Handler: "importAll", Label: "Import Everything", Hint: "Will import all content currently in the local Git repository."},
+				{Handler: "purge", Label: "Purge Local Repository", Hint: "Delete the local repository and clone it again from remote."},
+			},
+		},
+		{
+			module: newModule("s3", "Amazon S3",
+				stringProperty("region", "Region", "us-east-1"),
+				stringProperty("bucket", "Unique bucket name", ""),
+				stringProperty("accessKeyId", "Access Key ID", ""),
+				sensitiveProperty("secretAccessKey", "Secret Access Key"),
+				booleanProperty("sslEnabled", "Use SSL", true),
+			),
+			supportedModes: []string{"push"},
+			mode:           "push",
+			actions: []storageAction{
+				{Handler: "exportAll", Label: "Export All", Hint: "Output all content from the DB to S3."},
+			},
+		},
+		{
+			module: newModule("sftp", "SFTP",
+				stringProperty("host", "Host", ""),
+				numberProperty("port", "Port", 22),
+				enumProperty("authMode", "Authentication Method", "privateKey", "privateKey", "password"),
+				stringProperty("username", "Username", ""),
+				sensitiveProperty("privateKey", "Private Key Contents"),
+				sensitiveProperty("passphrase", "Private Key Passphrase"),
+				sensitiveProperty("password", "Password"),
+				stringProperty("basePath", "Base Directory Path", "/root/wiki"),
+			),
+			supportedModes: []string{"push"},
+			mode:           "push",
+			actions: []storageAction{
+				{Handler: "exportAll", Label: "Export All", Hint: "Output all content from the DB to the SFTP server."},
+			},
+		},
+	}
+	for _, target := range s.storageTargets {
+		target.status = "pending"
+	}
+
+	s.register("storage.targets", false, s.getStorageTargets)
+	s.register("storage.updateTargets", false, s.updateStorageTargets)
+	s.register("storage.status", false, s.getStorageStatus)
+	s.register("storage.executeAction", false, s.executeStorageAction)
+}
+
+// SetStorageStatus sets the status reported for a storage target, like a
+// scheduled sync of Wiki.js would.
+func (s *Server) SetStorageStatus(key, status, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if target := s.findStorageTarget(key); target != nil {
+		target.status = status
+		target.message = message
+		target.lastAttempt = storageTimestamp
+	}
+}
+
+// FailStorageActions makes storage actions fail with message, which Wiki.js
+// reports in the response of the action. An empty message makes them succeed
+// again.
+func (s *Server) FailStorageActions(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.storage.actionError = message
+}
+
+// ExecutedStorageActions returns the storage actions executed so far, as
+// targetKey/handler.
+func (s *Server) ExecutedStorageActions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.storage.executed...)
+}
+
+func (s *Server) findStorageTarget(key string) *storageTarget {
+	for _, target := range s.storageTargets {
+		if target.key == key {
+			return target
+		}
+	}
+	return nil
+}
+
+func (s *Server) getStorageTargets(variables json.RawMessage) (interface{}, error) {
+	targets := []map[string]interface{}{}
+	for _, target := range s.storageTargets {
+		targets = append(targets, map[string]interface{}{
+			"isAvailable":    true,
+			"isEnabled":      target.isEnabled,
+			"key":            target.key,
+			"title":          target.title,
+			"description":    "",
+			"logo":           "",
+			"website":        "",
+			"supportedModes": target.supportedModes,
+			"mode":           target.mode,
+			"hasSchedule":    target.hasSchedule,
+			"syncInterval":   target.syncInterval,
+			"config":         target.encodedConfig(),
+			"actions":        target.actions,
+		})
+	}
+	return targets, nil
+}
+
+// updateStorageTargets updates the targets passed, identified by key.
+func (s *Server) updateStorageTargets(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Targets []struct {
+			IsEnabled    bool           `json:"isEnabled"`
+			Key          string         `json:"key"`
+			Mode         string         `json:"mode"`
+			SyncInterval string         `json:"syncInterval"`
+			Config       []keyValuePair `json:"config"`
+		} `json:"targets"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, input := range args.Targets {
+		target := s.findStorageTarget(input.Key)
+		if target == nil {
+			return responseResult(fmt.Errorf("Invalid storage target %s", input.Key)), nil
+		}
+		if err := target.updateConfig(input.Config); err != nil {
+			return nil, err
+		}
+		target.isEnabled = input.IsEnabled
+		target.mode = input.Mode
+		target.syncInterval = input.SyncInterval
+	}
+	return responseResult(nil), nil
+}
+
+// getStorageStatus returns the status of the enabled targets.
+func (s *Server) getStorageStatus(variables json.RawMessage) (interface{}, error) {
+	statuses := []map[string]interface{}{}
+	for _, target := range s.storageTargets {
+		if !target.isEnabled {
+			continue
+		}
+		var lastAttempt interface{}
+		if target.lastAttempt != "" {
+			lastAttempt = target.lastAttempt
+		}
+		statuses = append(statuses, map[string]interface{}{
+			"key":         target.key,
+			"title":       target.title,
+			"status":      target.status,
+			"message":     target.message,
+			"lastAttempt": lastAttempt,
+		})
+	}
+	return statuses, nil
+}
+
+func (s *Server) executeStorageAction(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		TargetKey string `json:"targetKey"`
+		Handler   string `json:"handler"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	target := s.findStorageTarget(args.TargetKey)
+	if target == nil || !target.isEnabled {
+		return responseResult(fmt.Errorf("Invalid storage target or storage target is not enabled")), nil
+	}
+	found := false
+	for _, action := range target.actions {
+		if action.Handler == args.Handler {
+			found = true
+		}
+	}
+	if !found {
+		return responseResult(fmt.Errorf("Invalid storage action handler")), nil
+	}
+	// Wiki.js runs the action before responding and leaves the status alone.
+	s.storage.executed = append(s.storage.executed, args.TargetKey+"/"+args.Handler)
+	if s.storage.actionError != "" {
+		return responseResult(errors.New(s.storage.actionError)), nil
+	}
+	return responseResult(nil), nil
+}
@@ -0,0 +1,156 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+)
+
+// Version is the Wiki.js version reported by the fake server.
+const Version = "2.5.300"
+
+type systemState struct {
+	version   string
+	telemetry bool
+	flags     map[string]bool
+	ssl       sslState
+}
+
+type sslState struct {
+	httpRedirection bool
+	provider        string
+	domain          string
+	subscriberEmail string
+	expiration      time.Time
+	renewals        int
+}
+
+// SetVersion sets the Wiki.js version the server reports.
+func (s *Server) SetVersion(version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.system.version = version
+}
+
+// RemoveField removes a GraphQL field, e.g. comments.providers, so that
+// queries fail like on releases which lack it.
+func (s *Server) RemoveField(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.operations, name)
+}
+
+func (s *Server) registerSystem() {
+	s.system.version = Version
+	s.system.flags = map[string]bool{"ldapdebug": false, "sqllog": false}
+	s.register("system.info", false, s.getSystemInfo)
+	s.system.ssl = sslState{
+		provider:        "letsencrypt",
+		domain:          "wiki.example.com",
+		subscriberEmail: DefaultAdminEmail,
+		expiration:      time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	s.register("system.flags", false, s.getSystemFlags)
+	s.register("system.updateFlags", false, s.updateSystemFlags)
+	s.register("system.setHTTPSRedirection", false, s.setHTTPSRedirection)
+	s.register("system.renewHTTPSCertificate", false, s.renewHTTPSCertificate)
+}
+
+func (s *Server) getSystemInfo(variables json.RawMessage) (interface{}, error) {
+	return map[string]interface{}{
+		"currentVersion":   s.system.version,
+		"latestVersion":    Version,
+		"upgradeCapable":   false,
+		"dbType":           "postgres",
+		"dbVersion":        "11.16",
+		"nodeVersion":      "16.20.2",
+		"hostname":         "wikijs",
+		"operatingSystem":  "Linux 5.15.0",
+		"ramTotal":         "7.8 GB",
+		"workingDirectory": "/wiki",
+		"configFile":       "/wiki/config.yml",
+		"telemetry":        s.system.telemetry,
+		"groupsTotal":      2,
+		"pagesTotal":       len(s.pages.pages),
+		"usersTotal":       1,
+		"tagsTotal":        len(s.pages.tags),
+
+		"httpPort":           80,
+		"httpRedirection":    s.system.ssl.httpRedirection,
+		"httpsPort":          443,
+		"sslProvider":        s.system.ssl.provider,
+		"sslDomain":          s.system.ssl.domain,
+		"sslStatus":          "valid",
+		"sslSubscriberEmail": s.system.ssl.subscriberEmail,
+		"sslExpirationDate":  s.system.ssl.expiration.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *Server) getSystemFlags(variables json.RawMessage) (interface{}, error) {
+	keys := []string{}
+	for key := range s.system.flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	flags := []map[string]interface{}{}
+	for _, key := range keys {
+		flags = append(flags, map[string]interface{}{"key": key, "value": s.system.flags[key]})
+	}
+	return flags, nil
+}
+
+// updateSystemFlags replaces all flags, like Wiki.js does.
+func (s *Server) updateSystemFlags(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Flags []struct {
+			Key   string `json:"key"`
+			Value bool   `json:"value"`
+		} `json:"flags"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	s.system.flags = map[string]bool{}
+	for _, flag := range args.Flags {
+		s.system.flags[flag.Key] = flag.Value
+	}
+	return responseResult(nil), nil
+}
+
+// SetSSLProvider sets the SSL provider, letsencrypt by default. Certificates
+// can only be renewed with letsencrypt.
+func (s *Server) SetSSLProvider(provider string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.system.ssl.provider = provider
+}
+
+// SSLRenewals returns how often the certificate was renewed.
+func (s *Server) SSLRenewals() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.system.ssl.renewals
+}
+
+func (s *Server) setHTTPSRedirection(variables json.RawMessage) (interface{}, error) {
+	var args struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	s.system.ssl.httpRedirection = args.Enabled
+	return responseResult(nil), nil
+}
+
+// renewHTTPSCertificate extends the certificate by the 90 days of Let's
+// Encrypt certificates.
+func (s *Server) renewHTTPSCertificate(variables json.RawMessage) (interface{}, error) {
+	if s.system.ssl.provider != "letsencrypt" {
+		return responseResult(errors.New("Current provider does not support SSL certificate renewal.")), nil
+	}
+	s.system.ssl.expiration = s.system.ssl.expiration.AddDate(0, 0, 90)
+	s.system.ssl.renewals++
+	return responseResult(nil), nil
+}
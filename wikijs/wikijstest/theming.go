@@ -0,0 +1,73 @@
+package wikijstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	cssComment    = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	cssWhitespace = regexp.MustCompile(`\s+`)
+	cssPunctuator = regexp.MustCompile(`\s*([{}:;,>])\s*`)
+)
+
+type themingConfig struct {
+	Theme       string `json:"theme"`
+	Iconset     string `json:"iconset"`
+	DarkMode    bool   `json:"darkMode"`
+	TocPosition string `json:"tocPosition"`
+	InjectCSS   string `json:"injectCSS"`
+	InjectHead  string `json:"injectHead"`
+	InjectBody  string `json:"injectBody"`
+}
+
+func (s *Server) registerTheming() {
+	s.theming = themingConfig{
+		Theme:       "default",
+		Iconset:     "mdi",
+		TocPosition: "left",
+	}
+
+	s.register("theming.config", false, s.getThemingConfig)
+	s.register("theming.setConfig", false, s.setThemingConfig)
+}
+
+func (s *Server) getThemingConfig(variables json.RawMessage) (interface{}, error) {
+	return s.theming, nil
+}
+
+// setThemingConfig replaces all settings and minifies the CSS like Wiki.js.
+func (s *Server) setThemingConfig(variables json.RawMessage) (interface{}, error) {
+	var args map[string]interface{}
+	if err := json.Unmarshal(variables, &args); err != nil {
+		return nil, err
+	}
+	for _, required := range []string{"theme", "iconset", "darkMode"} {
+		if args[required] == nil {
+			return nil, fmt.Errorf("Variable \"$%s\" of required type was not provided.", required)
+		}
+	}
+
+	var config themingConfig
+	if err := json.Unmarshal(variables, &config); err != nil {
+		return nil, err
+	}
+	if config.TocPosition == "" {
+		config.TocPosition = "left"
+	}
+	config.InjectCSS = minifyCSS(config.InjectCSS)
+	s.theming = config
+	return responseResult(nil), nil
+}
+
+// minifyCSS removes comments and insignificant whitespace, a subset of what
+// clean-css does in Wiki.js.
+func minifyCSS(css string) string {
+	css = cssComment.ReplaceAllString(css, "")
+	css = cssWhitespace.ReplaceAllString(css, " ")
+	css = cssPunctuator.ReplaceAllString(css, "$1")
+	css = strings.ReplaceAll(css, ";}", "}")
+	return strings.TrimSpace(css)
+}